@@ -1,6 +1,7 @@
 package fwschemadata
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -22,3 +23,13 @@ type Data struct {
 	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/172
 	TerraformValue tftypes.Value
 }
+
+// nilDataDiagnostic returns the error diagnostic for a Data pointer method
+// called with a nil receiver, which would otherwise panic.
+func nilDataDiagnostic(method string) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Uninitialized Data",
+		"An unexpected error was encountered trying to call "+method+" on uninitialized data. "+
+			"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.",
+	)
+}
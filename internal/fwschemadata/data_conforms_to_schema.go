@@ -0,0 +1,130 @@
+package fwschemadata
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+)
+
+// ConformsToSchema walks the data and returns an error diagnostic for each
+// value whose type does not match the type defined by the schema at the same
+// path. Mismatches are reported at the most specific path possible, such as a
+// single list element, rather than at every parent value. Mismatches within a
+// set are reported at the set.
+func (d Data) ConformsToSchema(ctx context.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Errors are handled as richer diag.Diagnostics instead.
+	_ = tftypes.Walk(d.TerraformValue, func(tfTypePath *tftypes.AttributePath, tfTypeValue tftypes.Value) (bool, error) {
+		attrType, err := d.Schema.TypeAtTerraformPath(ctx, tfTypePath)
+
+		if err != nil {
+			diags.AddError(
+				d.Description.Title()+" Schema Mismatch",
+				"An unexpected error was encountered trying to retrieve type information while verifying the "+d.Description.String()+" conforms to its schema. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					"Path: "+tfTypePath.String()+"\n"+
+					"Error: "+err.Error(),
+			)
+
+			return false, nil
+		}
+
+		schemaTfType := attrType.TerraformType(ctx)
+
+		if tfTypeValue.Type().Equal(schemaTfType) {
+			return false, nil
+		}
+
+		// Descend into values with the same structure as the schema type, so
+		// the mismatch is reported at the most specific path possible. Set
+		// element paths are keyed by the element value, which cannot be
+		// converted using the schema type once it has drifted, so sets are
+		// reported as a whole.
+		if sameTerraformTypeStructure(tfTypeValue.Type(), schemaTfType) && !tfTypeValue.Type().Is(tftypes.Set{}) && terraformValueHasChildren(tfTypeValue) {
+			return true, nil
+		}
+
+		fwPath, fwPathDiags := fromtftypes.AttributePath(ctx, tfTypePath, d.Schema)
+
+		diags.Append(fwPathDiags...)
+
+		// Checking against fwPathDiags will capture all errors.
+		if fwPathDiags.HasError() {
+			return false, nil
+		}
+
+		diags.AddAttributeError(
+			fwPath,
+			d.Description.Title()+" Schema Mismatch",
+			"The "+d.Description.String()+" contains a value which does not conform to its schema. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Schema Type: %s\n", schemaTfType)+
+				fmt.Sprintf("Value Type: %s", tfTypeValue.Type()),
+		)
+
+		return false, nil
+	})
+
+	return diags
+}
+
+// sameTerraformTypeStructure returns true if both types are the same kind of
+// collection or structural type, ignoring element and attribute types.
+func sameTerraformTypeStructure(a, b tftypes.Type) bool {
+	switch a := a.(type) {
+	case tftypes.List:
+		return b.Is(tftypes.List{})
+	case tftypes.Map:
+		return b.Is(tftypes.Map{})
+	case tftypes.Set:
+		return b.Is(tftypes.Set{})
+	case tftypes.Object:
+		bObject, ok := b.(tftypes.Object)
+
+		if !ok || len(a.AttributeTypes) != len(bObject.AttributeTypes) {
+			return false
+		}
+
+		for name := range a.AttributeTypes {
+			if _, ok := bObject.AttributeTypes[name]; !ok {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return false
+	}
+}
+
+// terraformValueHasChildren returns true if the value is known, not null, and
+// contains at least one element or attribute.
+func terraformValueHasChildren(v tftypes.Value) bool {
+	if !v.IsKnown() || v.IsNull() {
+		return false
+	}
+
+	switch v.Type().(type) {
+	case tftypes.List, tftypes.Set:
+		var elements []tftypes.Value
+
+		if err := v.As(&elements); err != nil {
+			return false
+		}
+
+		return len(elements) > 0
+	case tftypes.Map, tftypes.Object:
+		var elements map[string]tftypes.Value
+
+		if err := v.As(&elements); err != nil {
+			return false
+		}
+
+		return len(elements) > 0
+	default:
+		return false
+	}
+}
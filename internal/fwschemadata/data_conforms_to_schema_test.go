@@ -0,0 +1,272 @@
+package fwschemadata_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDataConformsToSchema(t *testing.T) {
+	t.Parallel()
+
+	schema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"list_attribute": testschema.Attribute{
+				Optional: true,
+				Type: types.ListType{
+					ElemType: types.StringType,
+				},
+			},
+			"string_attribute": testschema.Attribute{
+				Optional: true,
+				Type:     types.StringType,
+			},
+		},
+		Blocks: map[string]fwschema.Block{
+			"single_block": testschema.Block{
+				NestedObject: testschema.NestedBlockObject{
+					Attributes: map[string]fwschema.Attribute{
+						"nested_attribute": testschema.Attribute{
+							Optional: true,
+							Type:     types.StringType,
+						},
+					},
+				},
+				NestingMode: fwschema.BlockNestingModeSingle,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		data          fwschemadata.Data
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			data: fwschemadata.Data{
+				Description:    fwschemadata.DataDescriptionPlan,
+				Schema:         schema,
+				TerraformValue: tftypes.NewValue(schema.Type().TerraformType(context.Background()), nil),
+			},
+		},
+		"conforming": {
+			data: fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionPlan,
+				Schema:      schema,
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"list_attribute": tftypes.List{
+								ElementType: tftypes.String,
+							},
+							"string_attribute": tftypes.String,
+							"single_block": tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"nested_attribute": tftypes.String,
+								},
+							},
+						},
+					},
+					map[string]tftypes.Value{
+						"list_attribute": tftypes.NewValue(
+							tftypes.List{
+								ElementType: tftypes.String,
+							},
+							[]tftypes.Value{
+								tftypes.NewValue(tftypes.String, "one"),
+							},
+						),
+						"string_attribute": tftypes.NewValue(tftypes.String, "test"),
+						"single_block": tftypes.NewValue(
+							tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"nested_attribute": tftypes.String,
+								},
+							},
+							map[string]tftypes.Value{
+								"nested_attribute": tftypes.NewValue(tftypes.String, "test"),
+							},
+						),
+					},
+				),
+			},
+		},
+		"drifted": {
+			data: fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionPlan,
+				Schema:      schema,
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"list_attribute": tftypes.List{
+								ElementType: tftypes.Number,
+							},
+							"string_attribute": tftypes.Bool,
+							"single_block": tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"nested_attribute": tftypes.String,
+								},
+							},
+						},
+					},
+					map[string]tftypes.Value{
+						"list_attribute": tftypes.NewValue(
+							tftypes.List{
+								ElementType: tftypes.Number,
+							},
+							[]tftypes.Value{
+								tftypes.NewValue(tftypes.Number, 1),
+							},
+						),
+						"string_attribute": tftypes.NewValue(tftypes.Bool, true),
+						"single_block": tftypes.NewValue(
+							tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"nested_attribute": tftypes.String,
+								},
+							},
+							map[string]tftypes.Value{
+								"nested_attribute": tftypes.NewValue(tftypes.String, "test"),
+							},
+						),
+					},
+				),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("list_attribute").AtListIndex(0),
+					"Plan Schema Mismatch",
+					"The plan contains a value which does not conform to its schema. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Schema Type: tftypes.String\n"+
+						"Value Type: tftypes.Number",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("string_attribute"),
+					"Plan Schema Mismatch",
+					"The plan contains a value which does not conform to its schema. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Schema Type: tftypes.String\n"+
+						"Value Type: tftypes.Bool",
+				),
+			},
+		},
+		"drifted-root": {
+			data: fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"string_attribute": testschema.Attribute{
+							Optional: true,
+							Type:     types.StringType,
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"other_attribute": tftypes.String,
+						},
+					},
+					map[string]tftypes.Value{
+						"other_attribute": tftypes.NewValue(tftypes.String, "test"),
+					},
+				),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"State Schema Mismatch",
+					"The state contains a value which does not conform to its schema. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Schema Type: "+types.ObjectType{AttrTypes: map[string]attr.Type{"string_attribute": types.StringType}}.TerraformType(context.Background()).String()+"\n"+
+						"Value Type: "+tftypes.Object{AttributeTypes: map[string]tftypes.Type{"other_attribute": tftypes.String}}.String(),
+				),
+			},
+		},
+		"drifted-set-element": {
+			data: fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"set_attribute": testschema.Attribute{
+							Optional: true,
+							Type: types.SetType{
+								ElemType: types.ObjectType{
+									AttrTypes: map[string]attr.Type{
+										"a": types.StringType,
+									},
+								},
+							},
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"set_attribute": tftypes.Set{
+								ElementType: tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"a": tftypes.Number,
+									},
+								},
+							},
+						},
+					},
+					map[string]tftypes.Value{
+						"set_attribute": tftypes.NewValue(
+							tftypes.Set{
+								ElementType: tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"a": tftypes.Number,
+									},
+								},
+							},
+							[]tftypes.Value{
+								tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"a": tftypes.Number,
+										},
+									},
+									map[string]tftypes.Value{
+										"a": tftypes.NewValue(tftypes.Number, 1),
+									},
+								),
+							},
+						),
+					},
+				),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("set_attribute"),
+					"State Schema Mismatch",
+					"The state contains a value which does not conform to its schema. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Schema Type: "+tftypes.Set{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{"a": tftypes.String}}}.String()+"\n"+
+						"Value Type: "+tftypes.Set{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{"a": tftypes.Number}}}.String(),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.data.ConformsToSchema(context.Background())
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -19,6 +19,12 @@ import (
 func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
+	if d == nil {
+		diags.Append(nilDataDiagnostic("TransformDefaults"))
+
+		return diags
+	}
+
 	configData := Data{
 		Description:    DataDescriptionConfiguration,
 		Schema:         d.Schema,
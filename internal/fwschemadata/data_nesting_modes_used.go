@@ -0,0 +1,51 @@
+package fwschemadata
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+)
+
+// NestingModesUsed returns the number of nested attributes in the schema for
+// each nesting mode, including nested attributes underneath other nested
+// attributes and blocks. Nesting modes which are not used are omitted.
+func (d Data) NestingModesUsed() map[fwschema.NestingMode]int {
+	attributeModes := map[fwschema.NestingMode]int{}
+
+	countNestingModes(d.Schema.GetAttributes(), d.Schema.GetBlocks(), attributeModes, map[fwschema.BlockNestingMode]int{})
+
+	return attributeModes
+}
+
+// BlockNestingModesUsed returns the number of blocks in the schema for each
+// block nesting mode, including blocks underneath other blocks. Nesting modes
+// which are not used are omitted.
+func (d Data) BlockNestingModesUsed() map[fwschema.BlockNestingMode]int {
+	blockModes := map[fwschema.BlockNestingMode]int{}
+
+	countNestingModes(d.Schema.GetAttributes(), d.Schema.GetBlocks(), map[fwschema.NestingMode]int{}, blockModes)
+
+	return blockModes
+}
+
+// countNestingModes recursively increments the given counts for each nested
+// attribute and block.
+func countNestingModes(attributes map[string]fwschema.Attribute, blocks map[string]fwschema.Block, attributeModes map[fwschema.NestingMode]int, blockModes map[fwschema.BlockNestingMode]int) {
+	for _, attribute := range attributes {
+		nestedAttribute, ok := attribute.(fwschema.NestedAttribute)
+
+		if !ok {
+			continue
+		}
+
+		attributeModes[nestedAttribute.GetNestingMode()]++
+
+		countNestingModes(nestedAttribute.GetNestedObject().GetAttributes(), nil, attributeModes, blockModes)
+	}
+
+	for _, block := range blocks {
+		blockModes[block.GetNestingMode()]++
+
+		nestedObject := block.GetNestedObject()
+
+		countNestingModes(nestedObject.GetAttributes(), nestedObject.GetBlocks(), attributeModes, blockModes)
+	}
+}
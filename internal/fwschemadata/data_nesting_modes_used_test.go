@@ -0,0 +1,165 @@
+package fwschemadata_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDataNestingModesUsed(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		data               fwschemadata.Data
+		expected           map[fwschema.NestingMode]int
+		expectedBlockModes map[fwschema.BlockNestingMode]int
+	}{
+		"no-nesting": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"string_attribute": testschema.Attribute{
+							Optional: true,
+							Type:     types.StringType,
+						},
+					},
+				},
+			},
+			expected:           map[fwschema.NestingMode]int{},
+			expectedBlockModes: map[fwschema.BlockNestingMode]int{},
+		},
+		"mixed": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"list_nested_attribute": testschema.NestedAttribute{
+							NestedObject: testschema.NestedAttributeObject{
+								Attributes: map[string]fwschema.Attribute{
+									"set_nested_attribute": testschema.NestedAttribute{
+										NestedObject: testschema.NestedAttributeObject{
+											Attributes: map[string]fwschema.Attribute{
+												"string_attribute": testschema.Attribute{
+													Optional: true,
+													Type:     types.StringType,
+												},
+											},
+										},
+										NestingMode: fwschema.NestingModeSet,
+										Optional:    true,
+									},
+								},
+							},
+							NestingMode: fwschema.NestingModeList,
+							Optional:    true,
+						},
+						"map_nested_attribute": testschema.NestedAttribute{
+							NestedObject: testschema.NestedAttributeObject{
+								Attributes: map[string]fwschema.Attribute{
+									"string_attribute": testschema.Attribute{
+										Optional: true,
+										Type:     types.StringType,
+									},
+								},
+							},
+							NestingMode: fwschema.NestingModeMap,
+							Optional:    true,
+						},
+						"string_attribute": testschema.Attribute{
+							Optional: true,
+							Type:     types.StringType,
+						},
+					},
+					Blocks: map[string]fwschema.Block{
+						"list_block": testschema.Block{
+							NestedObject: testschema.NestedBlockObject{
+								Attributes: map[string]fwschema.Attribute{
+									"single_nested_attribute": testschema.NestedAttribute{
+										NestedObject: testschema.NestedAttributeObject{
+											Attributes: map[string]fwschema.Attribute{
+												"string_attribute": testschema.Attribute{
+													Optional: true,
+													Type:     types.StringType,
+												},
+											},
+										},
+										NestingMode: fwschema.NestingModeSingle,
+										Optional:    true,
+									},
+								},
+								Blocks: map[string]fwschema.Block{
+									"set_block": testschema.Block{
+										NestedObject: testschema.NestedBlockObject{
+											Attributes: map[string]fwschema.Attribute{
+												"list_nested_attribute": testschema.NestedAttribute{
+													NestedObject: testschema.NestedAttributeObject{
+														Attributes: map[string]fwschema.Attribute{
+															"string_attribute": testschema.Attribute{
+																Optional: true,
+																Type:     types.StringType,
+															},
+														},
+													},
+													NestingMode: fwschema.NestingModeList,
+													Optional:    true,
+												},
+											},
+										},
+										NestingMode: fwschema.BlockNestingModeSet,
+									},
+								},
+							},
+							NestingMode: fwschema.BlockNestingModeList,
+						},
+						"single_block": testschema.Block{
+							NestedObject: testschema.NestedBlockObject{
+								Attributes: map[string]fwschema.Attribute{
+									"string_attribute": testschema.Attribute{
+										Optional: true,
+										Type:     types.StringType,
+									},
+								},
+							},
+							NestingMode: fwschema.BlockNestingModeSingle,
+						},
+					},
+				},
+			},
+			expected: map[fwschema.NestingMode]int{
+				fwschema.NestingModeList:   2,
+				fwschema.NestingModeMap:    1,
+				fwschema.NestingModeSet:    1,
+				fwschema.NestingModeSingle: 1,
+			},
+			expectedBlockModes: map[fwschema.BlockNestingMode]int{
+				fwschema.BlockNestingModeList:   1,
+				fwschema.BlockNestingModeSet:    1,
+				fwschema.BlockNestingModeSingle: 1,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.data.NestingModesUsed()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			gotBlockNestingModes := testCase.data.BlockNestingModesUsed()
+
+			if diff := cmp.Diff(gotBlockNestingModes, testCase.expectedBlockModes); diff != "" {
+				t.Errorf("unexpected block nesting modes difference: %s", diff)
+			}
+		})
+	}
+}
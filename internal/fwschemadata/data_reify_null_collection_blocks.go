@@ -15,6 +15,12 @@ import (
 func (d *Data) ReifyNullCollectionBlocks(ctx context.Context) diag.Diagnostics {
 	var diags diag.Diagnostics
 
+	if d == nil {
+		diags.Append(nilDataDiagnostic("ReifyNullCollectionBlocks"))
+
+		return diags
+	}
+
 	blockPathExpressions := fwschema.SchemaBlockPathExpressions(ctx, d.Schema)
 
 	// Errors are handled as richer diag.Diagnostics instead.
@@ -12,6 +12,10 @@ import (
 // Set replaces the entire value. The value should be a struct whose fields
 // have one of the attr.Value types. Each field must have the tfsdk field tag.
 func (d *Data) Set(ctx context.Context, val any) diag.Diagnostics {
+	if d == nil {
+		return diag.Diagnostics{nilDataDiagnostic("Set")}
+	}
+
 	attrValue, diags := reflect.FromValue(ctx, d.Schema.Type(), val, path.Empty())
 
 	if diags.HasError() {
@@ -25,6 +25,12 @@ import (
 func (d *Data) SetAtPath(ctx context.Context, path path.Path, val interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
+	if d == nil {
+		diags.Append(nilDataDiagnostic("SetAtPath"))
+
+		return diags
+	}
+
 	ctx = logging.FrameworkWithAttributePath(ctx, path.String())
 
 	tftypesPath, tftypesPathDiags := totftypes.AttributePath(ctx, path)
@@ -0,0 +1,98 @@
+package fwschemadata_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDataNilReceiver(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		method        func(context.Context, *fwschemadata.Data) diag.Diagnostics
+		expectedDiags diag.Diagnostics
+	}{
+		"NullifyCollectionBlocks": {
+			method: func(ctx context.Context, d *fwschemadata.Data) diag.Diagnostics {
+				return d.NullifyCollectionBlocks(ctx)
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Uninitialized Data",
+					"An unexpected error was encountered trying to call NullifyCollectionBlocks on uninitialized data. "+
+						"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+		"ReifyNullCollectionBlocks": {
+			method: func(ctx context.Context, d *fwschemadata.Data) diag.Diagnostics {
+				return d.ReifyNullCollectionBlocks(ctx)
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Uninitialized Data",
+					"An unexpected error was encountered trying to call ReifyNullCollectionBlocks on uninitialized data. "+
+						"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+		"Set": {
+			method: func(ctx context.Context, d *fwschemadata.Data) diag.Diagnostics {
+				return d.Set(ctx, struct{}{})
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Uninitialized Data",
+					"An unexpected error was encountered trying to call Set on uninitialized data. "+
+						"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+		"SetAtPath": {
+			method: func(ctx context.Context, d *fwschemadata.Data) diag.Diagnostics {
+				return d.SetAtPath(ctx, path.Root("test"), "test")
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Uninitialized Data",
+					"An unexpected error was encountered trying to call SetAtPath on uninitialized data. "+
+						"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+		"TransformDefaults": {
+			method: func(ctx context.Context, d *fwschemadata.Data) diag.Diagnostics {
+				return d.TransformDefaults(ctx, tftypes.NewValue(tftypes.Object{}, nil))
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Uninitialized Data",
+					"An unexpected error was encountered trying to call TransformDefaults on uninitialized data. "+
+						"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var data *fwschemadata.Data
+
+			diags := testCase.method(context.Background(), data)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package reflect
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// NewConverterValue creates a new reflect.Value by converting `val` into an
+// attr.Value using `typ`, then passing it to `converter`, which was
+// registered for the type of `target` in Options.
+//
+// It is meant to be called through Into, not directly.
+func NewConverterValue(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.Value, converter ConverterFunc, path path.Path) (reflect.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if typeWithValidate, ok := typ.(xattr.TypeWithValidate); ok {
+		diags.Append(typeWithValidate.Validate(ctx, val, path)...)
+
+		if diags.HasError() {
+			return target, diags
+		}
+	}
+
+	attrValue, err := typ.ValueFromTerraform(ctx, val)
+
+	if err != nil {
+		return target, append(diags, valueFromTerraformErrorDiag(err, path))
+	}
+
+	res, resDiags := converter(ctx, attrValue, path)
+	diags.Append(resDiags...)
+
+	if diags.HasError() {
+		return target, diags
+	}
+
+	if !res.IsValid() || res.Type() != target.Type() {
+		resType := "an invalid value"
+
+		if res.IsValid() {
+			resType = res.Type().String()
+		}
+
+		diags.AddAttributeError(
+			path,
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert the value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Converter registered for %s returned %s", target.Type(), resType),
+		)
+		return target, diags
+	}
+
+	return res, diags
+}
@@ -0,0 +1,136 @@
+package reflect_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNewConverterValue(t *testing.T) {
+	t.Parallel()
+
+	type timeStruct struct {
+		Time time.Time `tfsdk:"time"`
+	}
+
+	rfc3339Converter := func(_ context.Context, value attr.Value, path path.Path) (reflect.Value, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		str, ok := value.(types.String)
+
+		if !ok || str.IsNull() || str.IsUnknown() {
+			return reflect.ValueOf(time.Time{}), diags
+		}
+
+		t, err := time.Parse(time.RFC3339, str.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(path, "Invalid Time", err.Error())
+		}
+
+		return reflect.ValueOf(t), diags
+	}
+
+	objType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"time": types.StringType,
+		},
+	}
+
+	objTfType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"time": tftypes.String,
+		},
+	}
+
+	testCases := map[string]struct {
+		val           tftypes.Value
+		converters    map[reflect.Type]refl.ConverterFunc
+		expected      timeStruct
+		expectedDiags diag.Diagnostics
+	}{
+		"rfc3339": {
+			val: tftypes.NewValue(objTfType, map[string]tftypes.Value{
+				"time": tftypes.NewValue(tftypes.String, "2023-04-01T12:30:00Z"),
+			}),
+			converters: map[reflect.Type]refl.ConverterFunc{
+				reflect.TypeOf(time.Time{}): rfc3339Converter,
+			},
+			expected: timeStruct{
+				Time: time.Date(2023, time.April, 1, 12, 30, 0, 0, time.UTC),
+			},
+		},
+		"null": {
+			val: tftypes.NewValue(objTfType, map[string]tftypes.Value{
+				"time": tftypes.NewValue(tftypes.String, nil),
+			}),
+			converters: map[reflect.Type]refl.ConverterFunc{
+				reflect.TypeOf(time.Time{}): rfc3339Converter,
+			},
+			expected: timeStruct{},
+		},
+		"converter-error": {
+			val: tftypes.NewValue(objTfType, map[string]tftypes.Value{
+				"time": tftypes.NewValue(tftypes.String, "not-a-time"),
+			}),
+			converters: map[reflect.Type]refl.ConverterFunc{
+				reflect.TypeOf(time.Time{}): rfc3339Converter,
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("time"),
+					"Invalid Time",
+					`parsing time "not-a-time" as "2006-01-02T15:04:05Z07:00": cannot parse "not-a-time" as "2006"`,
+				),
+			},
+		},
+		"converter-wrong-type": {
+			val: tftypes.NewValue(objTfType, map[string]tftypes.Value{
+				"time": tftypes.NewValue(tftypes.String, "2023-04-01T12:30:00Z"),
+			}),
+			converters: map[reflect.Type]refl.ConverterFunc{
+				reflect.TypeOf(time.Time{}): func(_ context.Context, value attr.Value, _ path.Path) (reflect.Value, diag.Diagnostics) {
+					return reflect.ValueOf(value.String()), nil
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("time"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert the value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Converter registered for time.Time returned string",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got timeStruct
+
+			diags := refl.Into(context.Background(), objType, testCase.val, &got, refl.Options{Converters: testCase.converters}, path.Empty())
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
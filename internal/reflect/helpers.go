@@ -56,7 +56,7 @@ func getStructTags(_ context.Context, in reflect.Value, path path.Path) (map[str
 			// skip unexported fields
 			continue
 		}
-		tag := field.Tag.Get(`tfsdk`)
+		tag, tagOptions := parseStructTag(field.Tag.Get(`tfsdk`))
 		if tag == "-" {
 			// skip explicitly excluded fields
 			continue
@@ -68,6 +68,11 @@ func getStructTags(_ context.Context, in reflect.Value, path path.Path) (map[str
 		if !isValidFieldName(tag) {
 			return nil, fmt.Errorf("%s: invalid field name, must only use lowercase letters, underscores, and numbers, and must start with a letter", path)
 		}
+		for _, tagOption := range tagOptions {
+			if tagOption != structTagOptionRequired {
+				return nil, fmt.Errorf("%s: unknown struct tag option %q on %s", path, tagOption, field.Name)
+			}
+		}
 		if other, ok := tags[tag]; ok {
 			return nil, fmt.Errorf("%s: can't use field name for both %s and %s", path, typ.Field(other).Name, field.Name)
 		}
@@ -76,6 +81,32 @@ func getStructTags(_ context.Context, in reflect.Value, path path.Path) (map[str
 	return tags, nil
 }
 
+// structTagOptionRequired is the "tfsdk" struct tag option that marks a field
+// as required, meaning it cannot be populated from a null value.
+const structTagOptionRequired = "required"
+
+// parseStructTag splits a "tfsdk" struct tag into the field name and the
+// comma-separated options that follow it, such as `tfsdk:"name,required"`.
+func parseStructTag(tag string) (string, []string) {
+	name, options, found := strings.Cut(tag, ",")
+	if !found {
+		return name, nil
+	}
+	return name, strings.Split(options, ",")
+}
+
+// isRequiredStructField returns true if `field` has the required "tfsdk"
+// struct tag option.
+func isRequiredStructField(field reflect.StructField) bool {
+	_, tagOptions := parseStructTag(field.Tag.Get(`tfsdk`))
+	for _, tagOption := range tagOptions {
+		if tagOption == structTagOptionRequired {
+			return true
+		}
+	}
+	return false
+}
+
 // isValidFieldName returns true if `name` can be used as a field name in a
 // Terraform resource or data source.
 func isValidFieldName(name string) bool {
@@ -94,3 +125,38 @@ func canBeNil(target reflect.Value) bool {
 		return false
 	}
 }
+
+// copyDefaultValue returns a copy of the given Options.Defaults value, so
+// pointer, slice, and map defaults are not shared by every struct they are
+// set on. The pointed to value, slice elements, and map elements are copied
+// as-is.
+func copyDefaultValue(val reflect.Value) reflect.Value {
+	switch val.Kind() {
+	case reflect.Ptr:
+		if val.IsNil() {
+			return val
+		}
+		pointer := reflect.New(val.Type().Elem())
+		pointer.Elem().Set(val.Elem())
+		return pointer
+	case reflect.Slice:
+		if val.IsNil() {
+			return val
+		}
+		slice := reflect.MakeSlice(val.Type(), val.Len(), val.Len())
+		reflect.Copy(slice, val)
+		return slice
+	case reflect.Map:
+		if val.IsNil() {
+			return val
+		}
+		m := reflect.MakeMapWithSize(val.Type(), val.Len())
+		iter := val.MapRange()
+		for iter.Next() {
+			m.SetMapIndex(iter.Key(), iter.Value())
+		}
+		return m
+	default:
+		return val
+	}
+}
@@ -161,6 +161,35 @@ func TestGetStructTags_duplicateTag(t *testing.T) {
 	}
 }
 
+func TestGetStructTags_tagOptions(t *testing.T) {
+	t.Parallel()
+	type testStruct struct {
+		Required string `tfsdk:"required_field,required"`
+	}
+	res, err := getStructTags(context.Background(), reflect.ValueOf(testStruct{}), path.Empty())
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+	if len(res) != 1 || res["required_field"] != 0 {
+		t.Errorf("Unexpected result: %v", res)
+	}
+}
+
+func TestGetStructTags_unknownTagOption(t *testing.T) {
+	t.Parallel()
+	type testStruct struct {
+		Field string `tfsdk:"my_field,bogus"`
+	}
+	_, err := getStructTags(context.Background(), reflect.ValueOf(testStruct{}), path.Empty())
+	if err == nil {
+		t.Errorf("Expected error, got nil")
+	}
+	expected := `my_field: unknown struct tag option "bogus" on Field`
+	if err.Error() != expected {
+		t.Errorf("Expected error to be %q, got %q", expected, err.Error())
+	}
+}
+
 func TestGetStructTags_notAStruct(t *testing.T) {
 	t.Parallel()
 	var testStruct string
@@ -175,6 +204,41 @@ func TestGetStructTags_notAStruct(t *testing.T) {
 	}
 }
 
+func TestCopyDefaultValue(t *testing.T) {
+	t.Parallel()
+
+	number := 1
+	pointer := &number
+	slice := []string{"one"}
+	m := map[string]int{"one": 1}
+
+	pointerCopy := copyDefaultValue(reflect.ValueOf(pointer)).Interface().(*int)
+	if pointerCopy == pointer || *pointerCopy != number {
+		t.Errorf("expected copy of pointer to %d, got %p to %d", number, pointerCopy, *pointerCopy)
+	}
+
+	sliceCopy := copyDefaultValue(reflect.ValueOf(slice)).Interface().([]string)
+	sliceCopy[0] = "changed"
+	if slice[0] != "one" {
+		t.Errorf("expected slice copy, got default changed to %q", slice[0])
+	}
+
+	mapCopy := copyDefaultValue(reflect.ValueOf(m)).Interface().(map[string]int)
+	mapCopy["one"] = 2
+	if m["one"] != 1 {
+		t.Errorf("expected map copy, got default changed to %d", m["one"])
+	}
+
+	var nilSlice []string
+	if got := copyDefaultValue(reflect.ValueOf(nilSlice)).Interface().([]string); got != nil {
+		t.Errorf("expected nil slice, got %v", got)
+	}
+
+	if got := copyDefaultValue(reflect.ValueOf("one")).Interface().(string); got != "one" {
+		t.Errorf("expected %q, got %q", "one", got)
+	}
+}
+
 func TestIsValidFieldName(t *testing.T) {
 	t.Parallel()
 	tests := map[string]bool{
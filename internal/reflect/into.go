@@ -36,11 +36,19 @@ func Into(ctx context.Context, typ attr.Type, val tftypes.Value, target interfac
 		)
 		return diags
 	}
+	if opts.PreserveExisting {
+		opts.pendingWrites = &[]pendingWrite{}
+	}
 	result, diags := BuildValue(ctx, typ, val, v.Elem(), opts, path)
 	if diags.HasError() {
 		return diags
 	}
 	v.Elem().Set(result)
+	if opts.pendingWrites != nil {
+		for _, write := range *opts.pendingWrites {
+			write.pointer.Elem().Set(write.value)
+		}
+	}
 	return diags
 }
 
@@ -63,6 +71,11 @@ func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target re
 		)
 		return target, diags
 	}
+	// if the caller registered a converter for this type, it takes
+	// precedence over all of our default logic
+	if converter, ok := opts.Converters[target.Type()]; ok {
+		return NewConverterValue(ctx, typ, val, target, converter, path)
+	}
 	// if this is an attr.Value, build the type from that
 	if target.Type().Implements(reflect.TypeOf((*attr.Value)(nil)).Elem()) {
 		return NewAttributeValue(ctx, typ, val, target, opts, path)
@@ -131,6 +144,9 @@ func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target re
 		// we checked that target isn't an attr.Value
 		// all that's left to us now is to set it as an empty value or
 		// throw an error, depending on what's in opts
+		if opts.PreserveExisting && target.Kind() == reflect.Ptr && !target.IsNil() {
+			return target, nil
+		}
 		if canBeNil(target) || opts.UnhandledNullAsEmpty {
 			return reflect.Zero(target.Type()), nil
 		}
@@ -175,6 +191,10 @@ func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target re
 		val, valDiags := reflectSlice(ctx, typ, val, target, opts, path)
 		diags.Append(valDiags...)
 		return val, diags
+	case reflect.Array:
+		val, valDiags := reflectArray(ctx, typ, val, target, opts, path)
+		diags.Append(valDiags...)
+		return val, diags
 	case reflect.Map:
 		val, valDiags := Map(ctx, typ, val, target, opts, path)
 		diags.Append(valDiags...)
@@ -1,5 +1,14 @@
 package reflect
 
+import (
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
 // Options provides configuration settings for how the reflection behavior
 // works, letting callers tweak different behaviors based on their needs.
 type Options struct {
@@ -17,4 +26,53 @@ type Options struct {
 	// perfectly in the types they're being stored in, rather than
 	// returning errors. Numbers will always be rounded towards 0.
 	AllowRoundingNumbers bool
+
+	// CaseInsensitiveFieldMatch matches object attribute names to struct
+	// field tags without regard to case, rather than requiring an exact
+	// match. It is an error for more than one object attribute name to
+	// match the same struct field tag.
+	CaseInsensitiveFieldMatch bool
+
+	// Defaults maps struct field tag names to Go values to set on those
+	// fields when the corresponding object attribute is null, instead of
+	// the default reflection behavior. Each value must be assignable to
+	// the struct field type. Pointer, slice, and map values are copied for
+	// each struct they are set on, so changes to one struct do not affect
+	// the default or other structs.
+	// Defaults apply to any struct being reflected into, including nested
+	// structs, with a matching tag name. Fields tagged with the required
+	// option return an error on null values regardless of any default.
+	Defaults map[string]any
+
+	// PreserveExisting reuses non-nil pointers already set on the target,
+	// rather than replacing them with newly allocated pointers. A null
+	// value leaves the existing pointer in place and any other value is
+	// set on the existing allocation. Existing allocations are only
+	// written to by Into, once the whole value has been built without
+	// errors. Struct fields tagged with `tfsdk:"-"` also keep their
+	// existing values rather than being zeroed.
+	PreserveExisting bool
+
+	// pendingWrites collects the values to set on existing allocations
+	// when PreserveExisting is enabled, so Into can apply them after
+	// building the whole value.
+	pendingWrites *[]pendingWrite
+
+	// Converters maps Go types to functions that build a value of that
+	// type from an attr.Value, such as a time.Time from a String. When a
+	// target's type is found in the map, its function is used instead of
+	// the default reflection behavior, including for null and unknown
+	// values.
+	Converters map[reflect.Type]ConverterFunc
+}
+
+// ConverterFunc builds a reflect.Value from an attr.Value. The returned value
+// must be of the Go type the function is registered for in Options.
+type ConverterFunc func(context.Context, attr.Value, path.Path) (reflect.Value, diag.Diagnostics)
+
+// pendingWrite is a value to set on the allocation an existing pointer
+// references.
+type pendingWrite struct {
+	pointer reflect.Value
+	value   reflect.Value
 }
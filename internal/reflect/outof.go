@@ -64,7 +64,7 @@ func FromValue(ctx context.Context, typ attr.Type, val interface{}, path path.Pa
 		return FromBool(ctx, typ, value.Bool(), path)
 	case reflect.String:
 		return FromString(ctx, typ, value.String(), path)
-	case reflect.Slice:
+	case reflect.Slice, reflect.Array:
 		return FromSlice(ctx, typ, value, path)
 	case reflect.Map:
 		t, ok := typ.(attr.TypeWithElementType)
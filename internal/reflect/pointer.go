@@ -13,7 +13,10 @@ import (
 )
 
 // Pointer builds a new zero value of the concrete type that `target`
-// references, populates it with BuildValue, and takes a pointer to it.
+// references, populates it with BuildValue, and takes a pointer to it. If
+// opts.PreserveExisting is set and `target` is not nil, `target` is returned
+// instead and Into sets the populated value on it after building the whole
+// value.
 //
 // It is meant to be called through Into, not directly.
 func Pointer(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.Value, opts Options, path path.Path) (reflect.Value, diag.Diagnostics) {
@@ -27,6 +30,22 @@ func Pointer(ctx context.Context, typ attr.Type, val tftypes.Value, target refle
 		}))
 		return target, diags
 	}
+	// if we were asked to, reuse an existing pointer, deferring setting
+	// whatever it is pointing to until Into knows the whole value was
+	// built without errors
+	if opts.PreserveExisting && opts.pendingWrites != nil && !target.IsNil() {
+		pointed, pointedDiags := BuildValue(ctx, typ, val, target.Elem(), opts, path)
+		diags.Append(pointedDiags...)
+
+		if diags.HasError() {
+			return target, diags
+		}
+		*opts.pendingWrites = append(*opts.pendingWrites, pendingWrite{
+			pointer: target,
+			value:   pointed,
+		})
+		return target, diags
+	}
 	// we may have gotten a nil pointer, so we need to create our own that
 	// we can set
 	pointer := reflect.New(target.Type().Elem())
@@ -96,10 +96,137 @@ func reflectSlice(ctx context.Context, typ attr.Type, val tftypes.Value, target
 	return slice, diags
 }
 
+// build an array of elements, matching the type of `target`, and fill it with
+// the data in `val`. The number of elements in `val` must match the length of
+// the array.
+func reflectArray(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.Value, opts Options, path path.Path) (reflect.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// this only works with arrays, so check that out first
+	if target.Kind() != reflect.Array {
+		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+			Val:        val,
+			TargetType: target.Type(),
+			Err:        fmt.Errorf("expected an array type, got %s", target.Type()),
+		}))
+		return target, diags
+	}
+
+	var values []tftypes.Value
+	err := val.As(&values)
+	if err != nil {
+		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+			Val:        val,
+			TargetType: target.Type(),
+			Err:        err,
+		}))
+		return target, diags
+	}
+
+	if len(values) != target.Len() {
+		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+			Val:        val,
+			TargetType: target.Type(),
+			Err:        fmt.Errorf("expected %d elements, got %d", target.Len(), len(values)),
+		}))
+		return target, diags
+	}
+
+	// build the elements as a slice, then copy them into a new array
+	slice, sliceDiags := reflectSlice(ctx, typ, val, reflect.Zero(reflect.SliceOf(target.Type().Elem())), opts, path)
+	diags.Append(sliceDiags...)
+
+	if diags.HasError() {
+		return target, diags
+	}
+
+	array := reflect.New(target.Type()).Elem()
+	reflect.Copy(array, slice)
+
+	return array, diags
+}
+
+// IntoSlice populates `target`, which must be a pointer to a slice, with the
+// elements of `list`, which must be a list value. Unlike Into, which stops at
+// the first element that cannot be reflected, every element is reflected so
+// the returned diagnostics include each mismatched list index. `target` is
+// only modified when there are no errors.
+func IntoSlice(ctx context.Context, list attr.Value, target any, opts Options, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Slice {
+		err := fmt.Errorf("target must be a pointer to a slice, got %T", target)
+		diags.AddAttributeError(
+			path,
+			"Value Conversion Error",
+			fmt.Sprintf("An unexpected error was encountered trying to convert the value. This is always an error in the provider. Please report the following to the provider developer:\n\nPath: %s\nError: %s", path.String(), err.Error()),
+		)
+		return diags
+	}
+
+	typ := list.Type(ctx)
+	elemTyper, ok := typ.(attr.TypeWithElementType)
+	if !ok || !typ.TerraformType(ctx).Is(tftypes.List{}) {
+		err := fmt.Errorf("list must be a list value, got %T", list)
+		diags.AddAttributeError(
+			path,
+			"Value Conversion Error",
+			fmt.Sprintf("An unexpected error was encountered trying to convert the value. This is always an error in the provider. Please report the following to the provider developer:\n\nPath: %s\nError: %s", path.String(), err.Error()),
+		)
+		return diags
+	}
+
+	val, err := list.ToTerraformValue(ctx)
+	if err != nil {
+		return append(diags, toTerraformValueErrorDiag(err, path))
+	}
+
+	// null and unknown lists have no elements to collect diagnostics for,
+	// so defer to the standard handling
+	if val.IsNull() || !val.IsKnown() {
+		return Into(ctx, typ, val, target, opts, path)
+	}
+
+	var values []tftypes.Value
+	err = val.As(&values)
+	if err != nil {
+		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+			Val:        val,
+			TargetType: v.Elem().Type(),
+			Err:        err,
+		}))
+		return diags
+	}
+
+	elemType := v.Elem().Type().Elem()
+	elemAttrType := elemTyper.ElementType()
+	slice := reflect.MakeSlice(v.Elem().Type(), 0, len(values))
+
+	for pos, value := range values {
+		elem, elemDiags := BuildValue(ctx, elemAttrType, value, reflect.Zero(elemType), opts, path.AtListIndex(pos))
+		diags.Append(elemDiags...)
+
+		if elemDiags.HasError() {
+			continue
+		}
+
+		slice = reflect.Append(slice, elem)
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	v.Elem().Set(slice)
+
+	return diags
+}
+
 // FromSlice returns an attr.Value as produced by `typ` using the data in
-// `val`. `val` must be a slice. `typ` must be an attr.TypeWithElementType or
-// attr.TypeWithElementTypes. If the slice is nil, the representation of null
-// for `typ` will be returned. Otherwise, FromSlice will recurse into FromValue
+// `val`. `val` must be a slice or an array. `typ` must be an
+// attr.TypeWithElementType or attr.TypeWithElementTypes. If the slice is nil,
+// the representation of null for `typ` will be returned. Otherwise, FromSlice will recurse into FromValue
 // for each element in the slice, using the element type or types defined on
 // `typ` to construct values for them.
 //
@@ -110,7 +237,7 @@ func FromSlice(ctx context.Context, typ attr.Type, val reflect.Value, path path.
 	// TODO: support tuples, which are attr.TypeWithElementTypes
 	tfType := typ.TerraformType(ctx)
 
-	if val.IsNil() {
+	if val.Kind() == reflect.Slice && val.IsNil() {
 		tfVal := tftypes.NewValue(tfType, nil)
 
 		if typeWithValidate, ok := typ.(xattr.TypeWithValidate); ok {
@@ -0,0 +1,140 @@
+package reflect_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIntoSlice(t *testing.T) {
+	t.Parallel()
+
+	type myStruct struct {
+		Name string `tfsdk:"name"`
+	}
+
+	objType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name": types.StringType,
+		},
+	}
+
+	nullNameDiag := func(p path.Path) diag.Diagnostic {
+		return diag.NewAttributeErrorDiagnostic(
+			p,
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Received null value, however the target type cannot handle null values. Use the corresponding `types` package type, a pointer type or a custom type that handles null values.\n\n"+
+				fmt.Sprintf("Path: %s\nTarget Type: %s\nSuggested `types` Type: %s\nSuggested Pointer Type: *%s", p.String(), reflect.TypeOf(""), reflect.TypeOf(types.StringNull()), reflect.TypeOf("")),
+		)
+	}
+
+	testCases := map[string]struct {
+		list          attr.Value
+		expected      []myStruct
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			list:     types.ListNull(objType),
+			expected: nil,
+		},
+		"empty": {
+			list:     types.ListValueMust(objType, []attr.Value{}),
+			expected: []myStruct{},
+		},
+		"objects": {
+			list: types.ListValueMust(objType, []attr.Value{
+				types.ObjectValueMust(objType.AttrTypes, map[string]attr.Value{
+					"name": types.StringValue("one"),
+				}),
+				types.ObjectValueMust(objType.AttrTypes, map[string]attr.Value{
+					"name": types.StringValue("two"),
+				}),
+			}),
+			expected: []myStruct{
+				{Name: "one"},
+				{Name: "two"},
+			},
+		},
+		"element-errors": {
+			list: types.ListValueMust(objType, []attr.Value{
+				types.ObjectValueMust(objType.AttrTypes, map[string]attr.Value{
+					"name": types.StringValue("one"),
+				}),
+				types.ObjectValueMust(objType.AttrTypes, map[string]attr.Value{
+					"name": types.StringNull(),
+				}),
+				types.ObjectValueMust(objType.AttrTypes, map[string]attr.Value{
+					"name": types.StringNull(),
+				}),
+			}),
+			expected: nil,
+			expectedDiags: diag.Diagnostics{
+				nullNameDiag(path.Root("test").AtListIndex(1).AtName("name")),
+				nullNameDiag(path.Root("test").AtListIndex(2).AtName("name")),
+			},
+		},
+		"not-a-list": {
+			list:     types.SetNull(objType),
+			expected: nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert the value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Path: test\nError: list must be a list value, got basetypes.SetValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []myStruct
+
+			diags := refl.IntoSlice(context.Background(), testCase.list, &got, refl.Options{}, path.Root("test"))
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestIntoSlice_notASlice(t *testing.T) {
+	t.Parallel()
+
+	var got string
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(
+			path.Empty(),
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert the value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Path: \nError: target must be a pointer to a slice, got *string",
+		),
+	}
+
+	diags := refl.IntoSlice(context.Background(), types.ListNull(types.StringType), &got, refl.Options{}, path.Empty())
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+}
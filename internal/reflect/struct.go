@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -23,7 +24,10 @@ import (
 // attributes in the type of `object` must have a corresponding property.
 // Properties that don't map to object attributes must have a `tfsdk:"-"` tag,
 // explicitly defining them as not part of the object. This is to catch typos
-// and other mistakes early.
+// and other mistakes early. Properties tagged with the required option, such
+// as `tfsdk:"name,required"`, return an error if the object attribute is null.
+// Otherwise, properties whose tag name is in opts.Defaults are set to that
+// default value if the object attribute is null.
 //
 // Struct is meant to be called from Into, not directly.
 func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target reflect.Value, opts Options, path path.Path) (reflect.Value, diag.Diagnostics) {
@@ -81,18 +85,40 @@ func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target ref
 		return target, diags
 	}
 
+	// map the struct field names to the object attribute names, which are
+	// the same unless case-insensitive matching is enabled
+	objectFieldNames := make(map[string]string, len(objectFields))
+	for field := range objectFields {
+		if !opts.CaseInsensitiveFieldMatch {
+			objectFieldNames[field] = field
+			continue
+		}
+		lowerField := strings.ToLower(field)
+		if other, ok := objectFieldNames[lowerField]; ok {
+			names := []string{other, field}
+			sort.Strings(names)
+			diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+				Val:        object,
+				TargetType: target.Type(),
+				Err:        fmt.Errorf("object attributes %s and %s both match struct field %s when ignoring case", names[0], names[1], lowerField),
+			}))
+			return target, diags
+		}
+		objectFieldNames[lowerField] = field
+	}
+
 	// we require an exact, 1:1 match of these fields to avoid typos
 	// leading to surprises, so let's ensure they have the exact same
 	// fields defined
 	var objectMissing, targetMissing []string
 	for field := range targetFields {
-		if _, ok := objectFields[field]; !ok {
+		if _, ok := objectFieldNames[field]; !ok {
 			objectMissing = append(objectMissing, field)
 		}
 	}
-	for field := range objectFields {
+	for field, objectField := range objectFieldNames {
 		if _, ok := targetFields[field]; !ok {
-			targetMissing = append(targetMissing, field)
+			targetMissing = append(targetMissing, objectField)
 		}
 	}
 	if len(objectMissing) > 0 || len(targetMissing) > 0 {
@@ -116,7 +142,13 @@ func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target ref
 	// now that we know they match perfectly, fill the struct with the
 	// values in the object
 	result := reflect.New(target.Type()).Elem()
-	for field, structFieldPos := range targetFields {
+	if opts.PreserveExisting {
+		// start from the existing struct so its pointer fields can be
+		// reused when building the field values
+		result.Set(target)
+	}
+	for tag, structFieldPos := range targetFields {
+		field := objectFieldNames[tag]
 		attrType, ok := attrTypes[field]
 		if !ok {
 			diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
@@ -126,7 +158,34 @@ func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target ref
 			}))
 			return target, diags
 		}
+		if objectFields[field].IsNull() && isRequiredStructField(target.Type().Field(structFieldPos)) {
+			fieldPath := path.AtName(field)
+			diags.AddAttributeError(
+				fieldPath,
+				"Value Conversion Error",
+				"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					"Received null value, however the struct field is tagged as required.\n\n"+
+					fmt.Sprintf("Path: %s\nTarget Type: %s", fieldPath.String(), target.Type().Field(structFieldPos).Type),
+			)
+			return target, diags
+		}
 		structField := result.Field(structFieldPos)
+		if defaultValue, ok := opts.Defaults[tag]; ok && objectFields[field].IsNull() {
+			defaultVal := reflect.ValueOf(defaultValue)
+			if !defaultVal.IsValid() || !defaultVal.Type().AssignableTo(structField.Type()) {
+				fieldPath := path.AtName(field)
+				diags.AddAttributeError(
+					fieldPath,
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received null value, however the configured default cannot be assigned to the struct field.\n\n"+
+						fmt.Sprintf("Path: %s\nTarget Type: %s\nDefault Type: %T", fieldPath.String(), structField.Type(), defaultValue),
+				)
+				return target, diags
+			}
+			structField.Set(copyDefaultValue(defaultVal))
+			continue
+		}
 		fieldVal, fieldValDiags := BuildValue(ctx, attrType, objectFields[field], structField, opts, path.AtName(field))
 		diags.Append(fieldValDiags...)
 
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -157,6 +158,644 @@ func TestNewStruct_objectMissingFieldsAndStructMissingProperties(t *testing.T) {
 	}
 }
 
+func TestNewStruct_caseInsensitiveFieldMatch(t *testing.T) {
+	t.Parallel()
+
+	type myStruct struct {
+		Name    string `tfsdk:"name"`
+		Version string `tfsdk:"version"`
+	}
+
+	testCases := map[string]struct {
+		typ           attr.Type
+		val           tftypes.Value
+		opts          refl.Options
+		expected      myStruct
+		expectedDiags diag.Diagnostics
+	}{
+		"exact-match": {
+			typ: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"Name":    types.StringType,
+					"version": types.StringType,
+				},
+			},
+			val: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"Name":    tftypes.String,
+					"version": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"Name":    tftypes.NewValue(tftypes.String, "hello"),
+				"version": tftypes.NewValue(tftypes.String, "1.0"),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.WithPath(path.Empty(), refl.DiagIntoIncompatibleType{
+					Err: errors.New("mismatch between struct and object: Struct defines fields not found in object: name. Object defines fields not found in struct: Name."),
+					Val: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"Name":    tftypes.String,
+							"version": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"Name":    tftypes.NewValue(tftypes.String, "hello"),
+						"version": tftypes.NewValue(tftypes.String, "1.0"),
+					}),
+					TargetType: reflect.TypeOf(myStruct{}),
+				}),
+			},
+		},
+		"case-insensitive": {
+			typ: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"Name":    types.StringType,
+					"VERSION": types.StringType,
+				},
+			},
+			val: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"Name":    tftypes.String,
+					"VERSION": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"Name":    tftypes.NewValue(tftypes.String, "hello"),
+				"VERSION": tftypes.NewValue(tftypes.String, "1.0"),
+			}),
+			opts: refl.Options{
+				CaseInsensitiveFieldMatch: true,
+			},
+			expected: myStruct{
+				Name:    "hello",
+				Version: "1.0",
+			},
+		},
+		"case-insensitive-ambiguous": {
+			typ: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"Name":    types.StringType,
+					"NAME":    types.StringType,
+					"version": types.StringType,
+				},
+			},
+			val: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"Name":    tftypes.String,
+					"NAME":    tftypes.String,
+					"version": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"Name":    tftypes.NewValue(tftypes.String, "hello"),
+				"NAME":    tftypes.NewValue(tftypes.String, "world"),
+				"version": tftypes.NewValue(tftypes.String, "1.0"),
+			}),
+			opts: refl.Options{
+				CaseInsensitiveFieldMatch: true,
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.WithPath(path.Empty(), refl.DiagIntoIncompatibleType{
+					Err: errors.New("object attributes NAME and Name both match struct field name when ignoring case"),
+					Val: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"Name":    tftypes.String,
+							"NAME":    tftypes.String,
+							"version": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"Name":    tftypes.NewValue(tftypes.String, "hello"),
+						"NAME":    tftypes.NewValue(tftypes.String, "world"),
+						"version": tftypes.NewValue(tftypes.String, "1.0"),
+					}),
+					TargetType: reflect.TypeOf(myStruct{}),
+				}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var s myStruct
+
+			result, diags := refl.Struct(context.Background(), testCase.typ, testCase.val, reflect.ValueOf(s), testCase.opts, path.Empty())
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diags.HasError() {
+				return
+			}
+
+			reflect.ValueOf(&s).Elem().Set(result)
+
+			if diff := cmp.Diff(s, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewStruct_requiredField(t *testing.T) {
+	t.Parallel()
+
+	type myStruct struct {
+		Name types.String `tfsdk:"name,required"`
+	}
+
+	objType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name": types.StringType,
+		},
+	}
+
+	objTfType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+		},
+	}
+
+	testCases := map[string]struct {
+		val           tftypes.Value
+		expected      myStruct
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			val: tftypes.NewValue(objTfType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "hello"),
+			}),
+			expected: myStruct{
+				Name: types.StringValue("hello"),
+			},
+		},
+		"null": {
+			val: tftypes.NewValue(objTfType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, nil),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("name"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received null value, however the struct field is tagged as required.\n\n"+
+						"Path: name\nTarget Type: basetypes.StringValue",
+				),
+			},
+		},
+		"unknown": {
+			val: tftypes.NewValue(objTfType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			expected: myStruct{
+				Name: types.StringUnknown(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var s myStruct
+
+			result, diags := refl.Struct(context.Background(), objType, testCase.val, reflect.ValueOf(s), refl.Options{}, path.Empty())
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diags.HasError() {
+				return
+			}
+
+			reflect.ValueOf(&s).Elem().Set(result)
+
+			if diff := cmp.Diff(s, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewStruct_defaults(t *testing.T) {
+	t.Parallel()
+
+	type myStruct struct {
+		Name types.String `tfsdk:"name"`
+		Port int64        `tfsdk:"port"`
+		Tags []string     `tfsdk:"tags"`
+	}
+
+	objType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name": types.StringType,
+			"port": types.Int64Type,
+			"tags": types.ListType{ElemType: types.StringType},
+		},
+	}
+
+	tagsTfType := tftypes.List{ElementType: tftypes.String}
+	objTfType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+			"port": tftypes.Number,
+			"tags": tagsTfType,
+		},
+	}
+
+	testCases := map[string]struct {
+		val           tftypes.Value
+		defaults      map[string]any
+		expected      myStruct
+		expectedDiags diag.Diagnostics
+	}{
+		"null-default": {
+			val: tftypes.NewValue(objTfType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, nil),
+				"port": tftypes.NewValue(tftypes.Number, nil),
+				"tags": tftypes.NewValue(tagsTfType, nil),
+			}),
+			defaults: map[string]any{
+				"port": int64(443),
+			},
+			expected: myStruct{
+				Name: types.StringNull(),
+				Port: 443,
+			},
+		},
+		"known-overrides-default": {
+			val: tftypes.NewValue(objTfType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "example"),
+				"port": tftypes.NewValue(tftypes.Number, 8080),
+				"tags": tftypes.NewValue(tagsTfType, nil),
+			}),
+			defaults: map[string]any{
+				"name": types.StringValue("default"),
+				"port": int64(443),
+			},
+			expected: myStruct{
+				Name: types.StringValue("example"),
+				Port: 8080,
+			},
+		},
+		"unknown-ignores-default": {
+			val: tftypes.NewValue(objTfType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"port": tftypes.NewValue(tftypes.Number, 8080),
+				"tags": tftypes.NewValue(tagsTfType, nil),
+			}),
+			defaults: map[string]any{
+				"name": types.StringValue("default"),
+			},
+			expected: myStruct{
+				Name: types.StringUnknown(),
+				Port: 8080,
+			},
+		},
+		"null-default-wrong-type": {
+			val: tftypes.NewValue(objTfType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "example"),
+				"port": tftypes.NewValue(tftypes.Number, nil),
+				"tags": tftypes.NewValue(tagsTfType, nil),
+			}),
+			defaults: map[string]any{
+				"port": 443,
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("port"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received null value, however the configured default cannot be assigned to the struct field.\n\n"+
+						"Path: port\nTarget Type: int64\nDefault Type: int",
+				),
+			},
+		},
+		"null-default-slice": {
+			val: tftypes.NewValue(objTfType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "example"),
+				"port": tftypes.NewValue(tftypes.Number, 8080),
+				"tags": tftypes.NewValue(tagsTfType, nil),
+			}),
+			defaults: map[string]any{
+				"tags": []string{"default"},
+			},
+			expected: myStruct{
+				Name: types.StringValue("example"),
+				Port: 8080,
+				Tags: []string{"default"},
+			},
+		},
+		"null-no-default": {
+			val: tftypes.NewValue(objTfType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "example"),
+				"port": tftypes.NewValue(tftypes.Number, nil),
+				"tags": tftypes.NewValue(tagsTfType, nil),
+			}),
+			defaults: map[string]any{
+				"name": types.StringValue("default"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("port"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received null value, however the target type cannot handle null values. Use the corresponding `types` package type, a pointer type or a custom type that handles null values.\n\n"+
+						"Path: port\nTarget Type: int64\nSuggested `types` Type: basetypes.Int64Value\nSuggested Pointer Type: *int64",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var s myStruct
+
+			opts := refl.Options{
+				Defaults: testCase.defaults,
+			}
+
+			result, diags := refl.Struct(context.Background(), objType, testCase.val, reflect.ValueOf(s), opts, path.Empty())
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diags.HasError() {
+				return
+			}
+
+			reflect.ValueOf(&s).Elem().Set(result)
+
+			if diff := cmp.Diff(s, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			// slice defaults must be copied rather than shared
+			if tags, ok := testCase.defaults["tags"].([]string); ok && len(s.Tags) > 0 && &s.Tags[0] == &tags[0] {
+				t.Error("expected tags to be a copy of the default, got the default slice")
+			}
+		})
+	}
+}
+
+func TestNewStruct_preserveExisting(t *testing.T) {
+	t.Parallel()
+
+	type myStruct struct {
+		Name  *string `tfsdk:"name"`
+		Port  *int64  `tfsdk:"port"`
+		Count int64   `tfsdk:"count"`
+	}
+
+	objType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name":  types.StringType,
+			"port":  types.Int64Type,
+			"count": types.Int64Type,
+		},
+	}
+
+	objTfType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name":  tftypes.String,
+			"port":  tftypes.Number,
+			"count": tftypes.Number,
+		},
+	}
+
+	defaultName := "default"
+	defaultPort := int64(443)
+	exampleName := "example"
+	examplePort := int64(8080)
+
+	testCases := map[string]struct {
+		val              tftypes.Value
+		preserveExisting bool
+		expectedName     *string
+		expectedPort     *int64
+		expectSameName   bool
+		expectSamePort   bool
+		expectError      bool
+	}{
+		"null-preserved": {
+			val: tftypes.NewValue(objTfType, map[string]tftypes.Value{
+				"name":  tftypes.NewValue(tftypes.String, nil),
+				"port":  tftypes.NewValue(tftypes.Number, nil),
+				"count": tftypes.NewValue(tftypes.Number, 1),
+			}),
+			preserveExisting: true,
+			expectedName:     &defaultName,
+			expectedPort:     &defaultPort,
+			expectSameName:   true,
+			expectSamePort:   true,
+		},
+		"known-populates-existing": {
+			val: tftypes.NewValue(objTfType, map[string]tftypes.Value{
+				"name":  tftypes.NewValue(tftypes.String, "example"),
+				"port":  tftypes.NewValue(tftypes.Number, nil),
+				"count": tftypes.NewValue(tftypes.Number, 1),
+			}),
+			preserveExisting: true,
+			expectedName:     &exampleName,
+			expectedPort:     &defaultPort,
+			expectSameName:   true,
+			expectSamePort:   true,
+		},
+		"error-leaves-existing": {
+			val: tftypes.NewValue(objTfType, map[string]tftypes.Value{
+				"name":  tftypes.NewValue(tftypes.String, "example"),
+				"port":  tftypes.NewValue(tftypes.Number, 8080),
+				"count": tftypes.NewValue(tftypes.Number, nil),
+			}),
+			preserveExisting: true,
+			expectedName:     &defaultName,
+			expectedPort:     &defaultPort,
+			expectSameName:   true,
+			expectSamePort:   true,
+			expectError:      true,
+		},
+		"null-not-preserved": {
+			val: tftypes.NewValue(objTfType, map[string]tftypes.Value{
+				"name":  tftypes.NewValue(tftypes.String, nil),
+				"port":  tftypes.NewValue(tftypes.Number, nil),
+				"count": tftypes.NewValue(tftypes.Number, 1),
+			}),
+		},
+		"known-not-preserved": {
+			val: tftypes.NewValue(objTfType, map[string]tftypes.Value{
+				"name":  tftypes.NewValue(tftypes.String, "example"),
+				"port":  tftypes.NewValue(tftypes.Number, 8080),
+				"count": tftypes.NewValue(tftypes.Number, 1),
+			}),
+			expectedName: &exampleName,
+			expectedPort: &examplePort,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			existingName := defaultName
+			existingPort := defaultPort
+			s := myStruct{
+				Name: &existingName,
+				Port: &existingPort,
+			}
+
+			opts := refl.Options{
+				PreserveExisting: testCase.preserveExisting,
+			}
+
+			diags := refl.Into(context.Background(), objType, testCase.val, &s, opts, path.Empty())
+
+			if diags.HasError() != testCase.expectError {
+				t.Fatalf("expected error to be %t, got diagnostics: %s", testCase.expectError, diags)
+			}
+
+			if diff := cmp.Diff(s.Name, testCase.expectedName); diff != "" {
+				t.Errorf("unexpected name difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(s.Port, testCase.expectedPort); diff != "" {
+				t.Errorf("unexpected port difference: %s", diff)
+			}
+
+			if got := s.Name == &existingName; got != testCase.expectSameName {
+				t.Errorf("expected existing name pointer reused to be %t, got %t", testCase.expectSameName, got)
+			}
+
+			if got := s.Port == &existingPort; got != testCase.expectSamePort {
+				t.Errorf("expected existing port pointer reused to be %t, got %t", testCase.expectSamePort, got)
+			}
+		})
+	}
+}
+
+func TestNewStruct_customAttributeValue(t *testing.T) {
+	t.Parallel()
+
+	type myStruct struct {
+		Name testtypes.String `tfsdk:"name"`
+	}
+
+	objTfType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+		},
+	}
+
+	testCases := map[string]struct {
+		typ           attr.Type
+		val           tftypes.Value
+		expected      myStruct
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			typ: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"name": testtypes.StringType{},
+				},
+			},
+			val: tftypes.NewValue(objTfType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "hello"),
+			}),
+			expected: myStruct{
+				Name: testtypes.String{
+					InternalString: types.StringValue("hello"),
+					CreatedBy:      testtypes.StringType{},
+				},
+			},
+		},
+		"null": {
+			typ: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"name": testtypes.StringType{},
+				},
+			},
+			val: tftypes.NewValue(objTfType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, nil),
+			}),
+			expected: myStruct{
+				Name: testtypes.String{
+					InternalString: types.StringNull(),
+					CreatedBy:      testtypes.StringType{},
+				},
+			},
+		},
+		"unknown": {
+			typ: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"name": testtypes.StringType{},
+				},
+			},
+			val: tftypes.NewValue(objTfType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			expected: myStruct{
+				Name: testtypes.String{
+					InternalString: types.StringUnknown(),
+					CreatedBy:      testtypes.StringType{},
+				},
+			},
+		},
+		"schema-base-type": {
+			typ: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"name": types.StringType,
+				},
+			},
+			val: tftypes.NewValue(objTfType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "hello"),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.WithPath(path.Root("name"), refl.DiagNewAttributeValueIntoWrongType{
+					ValType:    reflect.TypeOf(types.StringValue("")),
+					TargetType: reflect.TypeOf(testtypes.String{}),
+					SchemaType: types.StringType,
+				}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var s myStruct
+
+			result, diags := refl.Struct(context.Background(), testCase.typ, testCase.val, reflect.ValueOf(s), refl.Options{}, path.Empty())
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diags.HasError() {
+				return
+			}
+
+			reflect.ValueOf(&s).Elem().Set(result)
+
+			if diff := cmp.Diff(s, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestNewStruct_primitives(t *testing.T) {
 	t.Parallel()
 
@@ -197,6 +836,212 @@ func TestNewStruct_primitives(t *testing.T) {
 	}
 }
 
+func TestNewStruct_pointerToStruct(t *testing.T) {
+	t.Parallel()
+
+	type nestedStruct struct {
+		A string `tfsdk:"a"`
+	}
+
+	type myStruct struct {
+		Nested *nestedStruct `tfsdk:"nested"`
+	}
+
+	objType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"nested": types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"a": types.StringType,
+				},
+			},
+		},
+	}
+
+	nestedTfType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"a": tftypes.String,
+		},
+	}
+
+	testCases := map[string]struct {
+		val      tftypes.Value
+		expected myStruct
+	}{
+		"null": {
+			val: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"nested": nestedTfType,
+				},
+			}, map[string]tftypes.Value{
+				"nested": tftypes.NewValue(nestedTfType, nil),
+			}),
+			expected: myStruct{},
+		},
+		"known": {
+			val: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"nested": nestedTfType,
+				},
+			}, map[string]tftypes.Value{
+				"nested": tftypes.NewValue(nestedTfType, map[string]tftypes.Value{
+					"a": tftypes.NewValue(tftypes.String, "hello"),
+				}),
+			}),
+			expected: myStruct{
+				Nested: &nestedStruct{
+					A: "hello",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var s myStruct
+
+			result, diags := refl.Struct(context.Background(), objType, testCase.val, reflect.ValueOf(s), refl.Options{}, path.Empty())
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			reflect.ValueOf(&s).Elem().Set(result)
+
+			if diff := cmp.Diff(s, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewStruct_array(t *testing.T) {
+	t.Parallel()
+
+	type myStruct struct {
+		Names [3]string `tfsdk:"names"`
+	}
+
+	objType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"names": types.ListType{ElemType: types.StringType},
+		},
+	}
+
+	listTfType := tftypes.List{ElementType: tftypes.String}
+	objTfType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"names": listTfType,
+		},
+	}
+
+	testCases := map[string]struct {
+		val           tftypes.Value
+		expected      myStruct
+		expectedDiags diag.Diagnostics
+	}{
+		"matching": {
+			val: tftypes.NewValue(objTfType, map[string]tftypes.Value{
+				"names": tftypes.NewValue(listTfType, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "one"),
+					tftypes.NewValue(tftypes.String, "two"),
+					tftypes.NewValue(tftypes.String, "three"),
+				}),
+			}),
+			expected: myStruct{
+				Names: [3]string{"one", "two", "three"},
+			},
+		},
+		"too-short": {
+			val: tftypes.NewValue(objTfType, map[string]tftypes.Value{
+				"names": tftypes.NewValue(listTfType, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "one"),
+					tftypes.NewValue(tftypes.String, "two"),
+				}),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.WithPath(path.Root("names"), refl.DiagIntoIncompatibleType{
+					Val: tftypes.NewValue(listTfType, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "one"),
+						tftypes.NewValue(tftypes.String, "two"),
+					}),
+					TargetType: reflect.TypeOf([3]string{}),
+					Err:        errors.New("expected 3 elements, got 2"),
+				}),
+			},
+		},
+		"too-long": {
+			val: tftypes.NewValue(objTfType, map[string]tftypes.Value{
+				"names": tftypes.NewValue(listTfType, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "one"),
+					tftypes.NewValue(tftypes.String, "two"),
+					tftypes.NewValue(tftypes.String, "three"),
+					tftypes.NewValue(tftypes.String, "four"),
+				}),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.WithPath(path.Root("names"), refl.DiagIntoIncompatibleType{
+					Val: tftypes.NewValue(listTfType, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "one"),
+						tftypes.NewValue(tftypes.String, "two"),
+						tftypes.NewValue(tftypes.String, "three"),
+						tftypes.NewValue(tftypes.String, "four"),
+					}),
+					TargetType: reflect.TypeOf([3]string{}),
+					Err:        errors.New("expected 3 elements, got 4"),
+				}),
+			},
+		},
+		"element-error": {
+			val: tftypes.NewValue(objTfType, map[string]tftypes.Value{
+				"names": tftypes.NewValue(listTfType, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "one"),
+					tftypes.NewValue(tftypes.String, nil),
+					tftypes.NewValue(tftypes.String, "three"),
+				}),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("names").AtListIndex(1),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received null value, however the target type cannot handle null values. Use the corresponding `types` package type, a pointer type or a custom type that handles null values.\n\n"+
+						"Path: names[1]\nTarget Type: string\nSuggested `types` Type: basetypes.StringValue\nSuggested Pointer Type: *string",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var s myStruct
+
+			result, diags := refl.Struct(context.Background(), objType, testCase.val, reflect.ValueOf(s), refl.Options{}, path.Empty())
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diags.HasError() {
+				return
+			}
+
+			reflect.ValueOf(&s).Elem().Set(result)
+
+			if diff := cmp.Diff(s, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestNewStruct_complex(t *testing.T) {
 	t.Parallel()
 
@@ -538,6 +1383,64 @@ func TestFromStruct_primitives(t *testing.T) {
 	}
 }
 
+func TestFromStruct_array(t *testing.T) {
+	t.Parallel()
+
+	type myStruct struct {
+		Names [3]string `tfsdk:"names"`
+	}
+
+	objType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"names": types.ListType{ElemType: types.StringType},
+		},
+	}
+
+	original := myStruct{
+		Names: [3]string{"one", "two", "three"},
+	}
+
+	actualVal, diags := refl.FromStruct(context.Background(), objType, reflect.ValueOf(original), path.Empty())
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %s", diags)
+	}
+
+	expectedVal := types.ObjectValueMust(
+		objType.AttrTypes,
+		map[string]attr.Value{
+			"names": types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("one"),
+				types.StringValue("two"),
+				types.StringValue("three"),
+			}),
+		},
+	)
+
+	if diff := cmp.Diff(expectedVal, actualVal); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	// converting the value back should produce the original struct
+	tfVal, err := actualVal.ToTerraformValue(context.Background())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var roundTripped myStruct
+
+	diags = refl.Into(context.Background(), objType, tfVal, &roundTripped, refl.Options{}, path.Empty())
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %s", diags)
+	}
+
+	if diff := cmp.Diff(original, roundTripped); diff != "" {
+		t.Errorf("unexpected round trip diff (+wanted, -got): %s", diff)
+	}
+}
+
 func TestFromStruct_complex(t *testing.T) {
 	t.Parallel()
 
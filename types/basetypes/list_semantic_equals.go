@@ -0,0 +1,102 @@
+package basetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// ListSemanticEqualsSorted returns true if the given List values contain the
+// same elements regardless of order, such as ["b", "a"] and ["a", "b"]. Each
+// prior element is matched with an equal proposed element, so duplicate
+// elements must appear the same number of times in both lists. Null and
+// unknown values are only equal to the same null or unknown value and lists
+// containing unknown elements are never equal.
+func ListSemanticEqualsSorted(ctx context.Context, prior, proposed ListValue) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if prior.IsNull() || prior.IsUnknown() || proposed.IsNull() || proposed.IsUnknown() {
+		return prior.Equal(proposed), diags
+	}
+
+	if !prior.ElementType(ctx).Equal(proposed.ElementType(ctx)) {
+		return false, diags
+	}
+
+	if len(prior.Elements()) != len(proposed.Elements()) {
+		return false, diags
+	}
+
+	priorValues, priorKnown, err := listElementTerraformValues(ctx, prior)
+
+	if err != nil {
+		diags.AddError(
+			"List Semantic Equality Error",
+			"An unexpected error was encountered trying to compare list values regardless of order. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Cannot convert prior value elements: %s", err),
+		)
+	}
+
+	proposedValues, proposedKnown, err := listElementTerraformValues(ctx, proposed)
+
+	if err != nil {
+		diags.AddError(
+			"List Semantic Equality Error",
+			"An unexpected error was encountered trying to compare list values regardless of order. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Cannot convert proposed value elements: %s", err),
+		)
+	}
+
+	if diags.HasError() || !priorKnown || !proposedKnown {
+		return false, diags
+	}
+
+	matched := make([]bool, len(proposedValues))
+
+	for _, priorValue := range priorValues {
+		found := false
+
+		for i, proposedValue := range proposedValues {
+			if matched[i] || !priorValue.Equal(proposedValue) {
+				continue
+			}
+
+			matched[i] = true
+			found = true
+
+			break
+		}
+
+		if !found {
+			return false, diags
+		}
+	}
+
+	return true, diags
+}
+
+// listElementTerraformValues returns the Terraform type system values of the
+// list elements and whether all elements are fully known.
+func listElementTerraformValues(ctx context.Context, list ListValue) ([]tftypes.Value, bool, error) {
+	elements := list.Elements()
+	values := make([]tftypes.Value, 0, len(elements))
+
+	for _, element := range elements {
+		tfValue, err := element.ToTerraformValue(ctx)
+
+		if err != nil {
+			return nil, false, err
+		}
+
+		if !tfValue.IsFullyKnown() {
+			return nil, false, nil
+		}
+
+		values = append(values, tfValue)
+	}
+
+	return values, true, nil
+}
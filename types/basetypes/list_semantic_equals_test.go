@@ -0,0 +1,196 @@
+package basetypes
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+func TestListSemanticEqualsSorted(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		prior    ListValue
+		proposed ListValue
+		expected bool
+	}{
+		"equal-identical": {
+			prior: NewListValueMust(
+				StringType{},
+				[]attr.Value{NewStringValue("a"), NewStringValue("b")},
+			),
+			proposed: NewListValueMust(
+				StringType{},
+				[]attr.Value{NewStringValue("a"), NewStringValue("b")},
+			),
+			expected: true,
+		},
+		"equal-reordered": {
+			prior: NewListValueMust(
+				StringType{},
+				[]attr.Value{NewStringValue("b"), NewStringValue("c"), NewStringValue("a")},
+			),
+			proposed: NewListValueMust(
+				StringType{},
+				[]attr.Value{NewStringValue("a"), NewStringValue("b"), NewStringValue("c")},
+			),
+			expected: true,
+		},
+		"equal-reordered-duplicates": {
+			prior: NewListValueMust(
+				StringType{},
+				[]attr.Value{NewStringValue("b"), NewStringValue("a"), NewStringValue("b")},
+			),
+			proposed: NewListValueMust(
+				StringType{},
+				[]attr.Value{NewStringValue("b"), NewStringValue("b"), NewStringValue("a")},
+			),
+			expected: true,
+		},
+		"equal-empty": {
+			prior:    NewListValueMust(StringType{}, []attr.Value{}),
+			proposed: NewListValueMust(StringType{}, []attr.Value{}),
+			expected: true,
+		},
+		"not-equal-elements": {
+			prior: NewListValueMust(
+				StringType{},
+				[]attr.Value{NewStringValue("b"), NewStringValue("a")},
+			),
+			proposed: NewListValueMust(
+				StringType{},
+				[]attr.Value{NewStringValue("a"), NewStringValue("c")},
+			),
+			expected: false,
+		},
+		"not-equal-duplicates": {
+			prior: NewListValueMust(
+				StringType{},
+				[]attr.Value{NewStringValue("a"), NewStringValue("a"), NewStringValue("b")},
+			),
+			proposed: NewListValueMust(
+				StringType{},
+				[]attr.Value{NewStringValue("a"), NewStringValue("b"), NewStringValue("b")},
+			),
+			expected: false,
+		},
+		"equal-reordered-numbers": {
+			prior: NewListValueMust(
+				NumberType{},
+				[]attr.Value{NewNumberValue(big.NewFloat(2.5)), NewNumberValue(big.NewFloat(1))},
+			),
+			proposed: NewListValueMust(
+				NumberType{},
+				[]attr.Value{NewNumberValue(big.NewFloat(1)), NewNumberValue(big.NewFloat(2.5))},
+			),
+			expected: true,
+		},
+		"not-equal-numbers-precision": {
+			prior: NewListValueMust(
+				NumberType{},
+				[]attr.Value{NewNumberValue(big.NewFloat(1.00000000001))},
+			),
+			proposed: NewListValueMust(
+				NumberType{},
+				[]attr.Value{NewNumberValue(big.NewFloat(1.00000000002))},
+			),
+			expected: false,
+		},
+		"not-equal-large-int64": {
+			prior: NewListValueMust(
+				Int64Type{},
+				[]attr.Value{NewInt64Value(12345678901)},
+			),
+			proposed: NewListValueMust(
+				Int64Type{},
+				[]attr.Value{NewInt64Value(12345678902)},
+			),
+			expected: false,
+		},
+		"not-equal-nested-string-escaping": {
+			prior: NewListValueMust(
+				ListType{ElemType: StringType{}},
+				[]attr.Value{
+					NewListValueMust(StringType{}, []attr.Value{NewStringValue("a"), NewStringValue("b")}),
+				},
+			),
+			proposed: NewListValueMust(
+				ListType{ElemType: StringType{}},
+				[]attr.Value{
+					NewListValueMust(StringType{}, []attr.Value{NewStringValue(`a", "b`)}),
+				},
+			),
+			expected: false,
+		},
+		"not-equal-length": {
+			prior: NewListValueMust(
+				StringType{},
+				[]attr.Value{NewStringValue("a")},
+			),
+			proposed: NewListValueMust(
+				StringType{},
+				[]attr.Value{NewStringValue("a"), NewStringValue("a")},
+			),
+			expected: false,
+		},
+		"not-equal-element-type": {
+			prior: NewListValueMust(
+				StringType{},
+				[]attr.Value{NewStringValue("1")},
+			),
+			proposed: NewListValueMust(
+				Int64Type{},
+				[]attr.Value{NewInt64Value(1)},
+			),
+			expected: false,
+		},
+		"not-equal-unknown-element": {
+			prior: NewListValueMust(
+				StringType{},
+				[]attr.Value{NewStringUnknown(), NewStringValue("a")},
+			),
+			proposed: NewListValueMust(
+				StringType{},
+				[]attr.Value{NewStringValue("a"), NewStringUnknown()},
+			),
+			expected: false,
+		},
+		"null-null": {
+			prior:    NewListNull(StringType{}),
+			proposed: NewListNull(StringType{}),
+			expected: true,
+		},
+		"null-known": {
+			prior:    NewListNull(StringType{}),
+			proposed: NewListValueMust(StringType{}, []attr.Value{}),
+			expected: false,
+		},
+		"unknown-known": {
+			prior:    NewListUnknown(StringType{}),
+			proposed: NewListValueMust(StringType{}, []attr.Value{}),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := ListSemanticEqualsSorted(context.Background(), testCase.prior, testCase.proposed)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diags.HasError() {
+				t.Errorf("unexpected diagnostics: %s", diags)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package basetypes
 import (
 	"context"
 	"fmt"
+	goreflect "reflect"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -155,8 +156,18 @@ func (l ListValue) Elements() []attr.Value {
 }
 
 // ElementsAs populates `target` with the elements of the ListValue, throwing an
-// error if the elements cannot be stored in `target`.
+// error if the elements cannot be stored in `target`. When `target` is a
+// pointer to a slice, such as a *[]MyStruct, every element is converted and
+// an error is returned for each element that cannot be stored.
 func (l ListValue) ElementsAs(ctx context.Context, target interface{}, allowUnhandled bool) diag.Diagnostics {
+	opts := reflect.Options{
+		UnhandledNullAsEmpty:    allowUnhandled,
+		UnhandledUnknownAsEmpty: allowUnhandled,
+	}
+	// collect the errors for every element when populating a slice
+	if v := goreflect.ValueOf(target); v.Kind() == goreflect.Ptr && v.Elem().Kind() == goreflect.Slice {
+		return reflect.IntoSlice(ctx, l, target, opts, path.Empty())
+	}
 	// we need a tftypes.Value for this List to be able to use it with our
 	// reflection code
 	values, err := l.ToTerraformValue(ctx)
@@ -168,10 +179,7 @@ func (l ListValue) ElementsAs(ctx context.Context, target interface{}, allowUnha
 			),
 		}
 	}
-	return reflect.Into(ctx, ListType{ElemType: l.elementType}, values, target, reflect.Options{
-		UnhandledNullAsEmpty:    allowUnhandled,
-		UnhandledUnknownAsEmpty: allowUnhandled,
-	}, path.Empty())
+	return reflect.Into(ctx, ListType{ElemType: l.elementType}, values, target, opts, path.Empty())
 }
 
 // ElementType returns the element type for the List.
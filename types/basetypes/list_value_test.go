@@ -219,6 +219,91 @@ func TestListElementsAs_stringSlice(t *testing.T) {
 	}
 }
 
+func TestListElementsAs_structSlice(t *testing.T) {
+	t.Parallel()
+
+	type myStruct struct {
+		Name string `tfsdk:"name"`
+	}
+
+	objType := ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name": StringType{},
+		},
+	}
+
+	nullNameDiag := func(p path.Path) diag.Diagnostic {
+		return diag.NewAttributeErrorDiagnostic(
+			p,
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Received null value, however the target type cannot handle null values. Use the corresponding `types` package type, a pointer type or a custom type that handles null values.\n\n"+
+				"Path: "+p.String()+"\nTarget Type: string\nSuggested `types` Type: basetypes.StringValue\nSuggested Pointer Type: *string",
+		)
+	}
+
+	testCases := map[string]struct {
+		list          ListValue
+		expected      []myStruct
+		expectedDiags diag.Diagnostics
+	}{
+		"objects": {
+			list: NewListValueMust(objType, []attr.Value{
+				NewObjectValueMust(objType.AttrTypes, map[string]attr.Value{
+					"name": NewStringValue("one"),
+				}),
+				NewObjectValueMust(objType.AttrTypes, map[string]attr.Value{
+					"name": NewStringValue("two"),
+				}),
+			}),
+			expected: []myStruct{
+				{Name: "one"},
+				{Name: "two"},
+			},
+		},
+		"element-errors": {
+			list: NewListValueMust(objType, []attr.Value{
+				NewObjectValueMust(objType.AttrTypes, map[string]attr.Value{
+					"name": NewStringValue("one"),
+				}),
+				NewObjectValueMust(objType.AttrTypes, map[string]attr.Value{
+					"name": NewStringNull(),
+				}),
+				NewObjectValueMust(objType.AttrTypes, map[string]attr.Value{
+					"name": NewStringNull(),
+				}),
+			}),
+			expectedDiags: diag.Diagnostics{
+				nullNameDiag(path.Empty().AtListIndex(1).AtName("name")),
+				nullNameDiag(path.Empty().AtListIndex(2).AtName("name")),
+			},
+		},
+		"null": {
+			list: NewListNull(objType),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var target []myStruct
+
+			diags := testCase.list.ElementsAs(context.Background(), &target, false)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(target, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestListElementsAs_attributeValueSlice(t *testing.T) {
 	t.Parallel()
 
@@ -3,6 +3,7 @@ package basetypes
 import (
 	"context"
 	"fmt"
+	goreflect "reflect"
 	"sort"
 	"strings"
 
@@ -188,8 +189,41 @@ type ObjectAsOptions struct {
 	// distinction. When set to true, the type's empty value will be used.
 	// When set to false, an error will be returned.
 	UnhandledUnknownAsEmpty bool
+
+	// CaseInsensitiveFieldMatch controls whether object attribute names
+	// are matched to struct field tags without regard to case. When set
+	// to true, it is an error for more than one object attribute name to
+	// match the same struct field tag.
+	CaseInsensitiveFieldMatch bool
+
+	// PreserveExisting controls whether non-nil pointers already set on
+	// the target are reused rather than replaced with newly allocated
+	// pointers. When set to true, a null value leaves the existing
+	// pointer in place and any other value is set on the existing
+	// allocation. The target is only modified if As returns no errors.
+	PreserveExisting bool
+
+	// Defaults maps struct field tag names to Go values to set on those
+	// fields when the corresponding object attribute is null. Each value
+	// must be assignable to the struct field type. Pointer, slice, and map
+	// values are copied for each struct they are set on, so they are not
+	// shared. Defaults apply to any struct being populated, including
+	// nested structs, with a matching tag name.
+	Defaults map[string]any
+
+	// Converters maps Go types to functions that build a value of that
+	// type from an attr.Value, such as a time.Time from a StringValue.
+	// When As needs to populate a value of a type in the map, its function
+	// is used instead of the default conversion rules, including for null
+	// and unknown values. The function must return a value of the type it
+	// is registered for.
+	Converters map[goreflect.Type]ObjectAsConverterFunc
 }
 
+// ObjectAsConverterFunc builds a Go value from an attr.Value. It is registered
+// for a Go type in ObjectAsOptions.Converters.
+type ObjectAsConverterFunc func(ctx context.Context, value attr.Value, path path.Path) (goreflect.Value, diag.Diagnostics)
+
 // As populates `target` with the data in the ObjectValue, throwing an error if the
 // data cannot be stored in `target`.
 func (o ObjectValue) As(ctx context.Context, target interface{}, opts ObjectAsOptions) diag.Diagnostics {
@@ -205,9 +239,20 @@ func (o ObjectValue) As(ctx context.Context, target interface{}, opts ObjectAsOp
 			),
 		}
 	}
+	var converters map[goreflect.Type]reflect.ConverterFunc
+	if len(opts.Converters) > 0 {
+		converters = make(map[goreflect.Type]reflect.ConverterFunc, len(opts.Converters))
+		for typ, converter := range opts.Converters {
+			converters[typ] = reflect.ConverterFunc(converter)
+		}
+	}
 	return reflect.Into(ctx, obj, val, target, reflect.Options{
-		UnhandledNullAsEmpty:    opts.UnhandledNullAsEmpty,
-		UnhandledUnknownAsEmpty: opts.UnhandledUnknownAsEmpty,
+		UnhandledNullAsEmpty:      opts.UnhandledNullAsEmpty,
+		UnhandledUnknownAsEmpty:   opts.UnhandledUnknownAsEmpty,
+		CaseInsensitiveFieldMatch: opts.CaseInsensitiveFieldMatch,
+		Defaults:                  opts.Defaults,
+		PreserveExisting:          opts.PreserveExisting,
+		Converters:                converters,
 	}, path.Empty())
 }
 
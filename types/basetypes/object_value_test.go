@@ -3,7 +3,9 @@ package basetypes
 import (
 	"context"
 	"math/big"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -562,6 +564,204 @@ func TestObjectAs_struct(t *testing.T) {
 	}
 }
 
+func TestObjectAs_converters(t *testing.T) {
+	t.Parallel()
+
+	type myStruct struct {
+		Created time.Time `tfsdk:"created"`
+	}
+
+	timeConverter := func(_ context.Context, value attr.Value, p path.Path) (reflect.Value, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		s, ok := value.(StringValue)
+
+		if !ok || s.IsNull() || s.IsUnknown() {
+			return reflect.ValueOf(time.Time{}), diags
+		}
+
+		t, err := time.Parse(time.RFC3339, s.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(p, "Invalid Timestamp", err.Error())
+		}
+
+		return reflect.ValueOf(t), diags
+	}
+
+	attrTypes := map[string]attr.Type{
+		"created": StringType{},
+	}
+
+	testCases := map[string]struct {
+		object        ObjectValue
+		expected      myStruct
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			object: NewObjectValueMust(attrTypes, map[string]attr.Value{
+				"created": NewStringValue("2023-04-05T06:07:08Z"),
+			}),
+			expected: myStruct{
+				Created: time.Date(2023, 4, 5, 6, 7, 8, 0, time.UTC),
+			},
+		},
+		"null": {
+			object: NewObjectValueMust(attrTypes, map[string]attr.Value{
+				"created": NewStringNull(),
+			}),
+			expected: myStruct{},
+		},
+		"converter-error": {
+			object: NewObjectValueMust(attrTypes, map[string]attr.Value{
+				"created": NewStringValue("yesterday"),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("created"),
+					"Invalid Timestamp",
+					`parsing time "yesterday" as "2006-01-02T15:04:05Z07:00": cannot parse "yesterday" as "2006"`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var target myStruct
+
+			diags := testCase.object.As(context.Background(), &target, ObjectAsOptions{
+				Converters: map[reflect.Type]ObjectAsConverterFunc{
+					reflect.TypeOf(time.Time{}): timeConverter,
+				},
+			})
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(target, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestObjectAs_caseInsensitiveFieldMatch(t *testing.T) {
+	t.Parallel()
+
+	type myStruct struct {
+		Name string `tfsdk:"name"`
+	}
+
+	object := NewObjectValueMust(
+		map[string]attr.Type{
+			"Name": StringType{},
+		},
+		map[string]attr.Value{
+			"Name": NewStringValue("example"),
+		},
+	)
+
+	var target myStruct
+
+	diags := object.As(context.Background(), &target, ObjectAsOptions{
+		CaseInsensitiveFieldMatch: true,
+	})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %s", diags)
+	}
+
+	if diff := cmp.Diff(target, myStruct{Name: "example"}); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestObjectAs_defaults(t *testing.T) {
+	t.Parallel()
+
+	type myStruct struct {
+		Name string `tfsdk:"name"`
+		Port int64  `tfsdk:"port"`
+	}
+
+	object := NewObjectValueMust(
+		map[string]attr.Type{
+			"name": StringType{},
+			"port": Int64Type{},
+		},
+		map[string]attr.Value{
+			"name": NewStringValue("example"),
+			"port": NewInt64Null(),
+		},
+	)
+
+	var target myStruct
+
+	diags := object.As(context.Background(), &target, ObjectAsOptions{
+		Defaults: map[string]any{
+			"name": "default",
+			"port": int64(443),
+		},
+	})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %s", diags)
+	}
+
+	if diff := cmp.Diff(target, myStruct{Name: "example", Port: 443}); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestObjectAs_preserveExisting(t *testing.T) {
+	t.Parallel()
+
+	type myStruct struct {
+		Name *string `tfsdk:"name"`
+		Port *int64  `tfsdk:"port"`
+	}
+
+	object := NewObjectValueMust(
+		map[string]attr.Type{
+			"name": StringType{},
+			"port": Int64Type{},
+		},
+		map[string]attr.Value{
+			"name": NewStringValue("example"),
+			"port": NewInt64Null(),
+		},
+	)
+
+	name := "default"
+	port := int64(443)
+	target := myStruct{
+		Name: &name,
+		Port: &port,
+	}
+
+	diags := object.As(context.Background(), &target, ObjectAsOptions{
+		PreserveExisting: true,
+	})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %s", diags)
+	}
+
+	if target.Name != &name || name != "example" {
+		t.Errorf("expected existing name pointer set to \"example\", got %p with %q", target.Name, *target.Name)
+	}
+
+	if target.Port != &port || port != 443 {
+		t.Errorf("expected existing port pointer kept with 443, got %p with %d", target.Port, *target.Port)
+	}
+}
+
 func TestObjectValueAttributes(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,198 @@
+package basetypes
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// StringSemanticEqualsNumeric returns true if the given String values both
+// contain a finite number and those numbers are equal, such as "1.0" and "1".
+// Null and unknown values are only equal to the same null or unknown value.
+//
+// An error diagnostic naming the invalid value is returned if either known
+// value is not a finite number, such as "abc" or "Inf".
+func StringSemanticEqualsNumeric(prior, proposed StringValue) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if prior.IsNull() || prior.IsUnknown() || proposed.IsNull() || proposed.IsUnknown() {
+		return prior.Equal(proposed), diags
+	}
+
+	priorNumber, err := parseFiniteNumber(prior.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"Invalid Number String",
+			fmt.Sprintf("Cannot compare the prior value %q as a number: %s", prior.ValueString(), err),
+		)
+	}
+
+	proposedNumber, err := parseFiniteNumber(proposed.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"Invalid Number String",
+			fmt.Sprintf("Cannot compare the proposed value %q as a number: %s", proposed.ValueString(), err),
+		)
+	}
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	return priorNumber.Cmp(proposedNumber) == 0, diags
+}
+
+// parseFiniteNumber parses the given string as a base 10 number, returning an
+// error for infinite values.
+func parseFiniteNumber(s string) (*big.Float, error) {
+	number, _, err := big.ParseFloat(s, 10, 512, big.ToNearestEven)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if number.IsInf() {
+		return nil, errors.New("infinite values are not supported")
+	}
+
+	return number, nil
+}
+
+// StringSemanticEqualsFold returns true if the given String values are equal
+// under Unicode case-folding, such as "Example.COM" and "example.com". Null
+// and unknown values are only equal to the same null or unknown value.
+func StringSemanticEqualsFold(prior, proposed StringValue) (bool, diag.Diagnostics) {
+	if prior.IsNull() || prior.IsUnknown() || proposed.IsNull() || proposed.IsUnknown() {
+		return prior.Equal(proposed), nil
+	}
+
+	return strings.EqualFold(prior.ValueString(), proposed.ValueString()), nil
+}
+
+// StringSemanticEqualsIgnoreWhitespace returns true if the given String values
+// are equal after whitespace normalization. Leading and trailing whitespace
+// is removed and each internal run of whitespace, including newlines and
+// tabs, is collapsed to a single space. For example, "a  b\n" and "a b" are
+// equal. Null and unknown values are only equal to the same null or unknown
+// value.
+func StringSemanticEqualsIgnoreWhitespace(prior, proposed StringValue) (bool, diag.Diagnostics) {
+	if prior.IsNull() || prior.IsUnknown() || proposed.IsNull() || proposed.IsUnknown() {
+		return prior.Equal(proposed), nil
+	}
+
+	return normalizeWhitespace(prior.ValueString()) == normalizeWhitespace(proposed.ValueString()), nil
+}
+
+// normalizeWhitespace trims leading and trailing whitespace and collapses each
+// internal run of whitespace into a single space.
+func normalizeWhitespace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// StringSemanticEqualsURL returns true if the given String values both contain
+// a URL and those URLs are equal after normalization, such as
+// "HTTPS://Example.com:443/a/" and "https://example.com/a". Null and unknown
+// values are only equal to the same null or unknown value.
+//
+// URLs are normalized as follows:
+//
+//   - The scheme and host are lowercased.
+//   - The default port is removed for the http (80) and https (443) schemes.
+//   - When a host is present, the escaped path is cleaned, which resolves "."
+//     and ".." segments and removes duplicate and trailing slashes, and an
+//     empty path is treated as "/". Percent-encoded characters, such as an
+//     encoded slash, are preserved.
+//
+// Relative references, userinfo, query, and fragment components are compared
+// as-is. An error diagnostic naming the invalid value is returned if either
+// known value cannot be parsed as a URL.
+func StringSemanticEqualsURL(prior, proposed StringValue) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if prior.IsNull() || prior.IsUnknown() || proposed.IsNull() || proposed.IsUnknown() {
+		return prior.Equal(proposed), diags
+	}
+
+	priorURL, err := normalizeURL(prior.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"Invalid URL String",
+			fmt.Sprintf("Cannot compare the prior value %q as a URL: %s", prior.ValueString(), err),
+		)
+	}
+
+	proposedURL, err := normalizeURL(proposed.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"Invalid URL String",
+			fmt.Sprintf("Cannot compare the proposed value %q as a URL: %s", proposed.ValueString(), err),
+		)
+	}
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	return priorURL == proposedURL, diags
+}
+
+// normalizeURL parses the given string as a URL and returns its normalized
+// form, as described by StringSemanticEqualsURL.
+func normalizeURL(s string) (string, error) {
+	u, err := url.Parse(s)
+
+	if err != nil {
+		return "", err
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+
+	if u.Host == "" {
+		return u.String(), nil
+	}
+
+	host := strings.ToLower(u.Hostname())
+	port := u.Port()
+
+	if (u.Scheme == "http" && port == "80") || (u.Scheme == "https" && port == "443") {
+		port = ""
+	}
+
+	if port != "" {
+		host = net.JoinHostPort(host, port)
+	} else if strings.Contains(host, ":") {
+		// Hostname removes the brackets around IPv6 addresses
+		host = "[" + host + "]"
+	}
+
+	u.Host = host
+
+	// clean the escaped path so encoded characters, such as %2F, are not
+	// treated as path separators
+	escapedPath := "/"
+
+	if u.EscapedPath() != "" {
+		escapedPath = path.Clean(u.EscapedPath())
+	}
+
+	decodedPath, err := url.PathUnescape(escapedPath)
+
+	if err != nil {
+		return "", err
+	}
+
+	u.Path = decodedPath
+	u.RawPath = escapedPath
+
+	return u.String(), nil
+}
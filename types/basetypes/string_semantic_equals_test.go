@@ -0,0 +1,406 @@
+package basetypes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestStringSemanticEqualsNumeric(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		prior         StringValue
+		proposed      StringValue
+		expected      bool
+		expectedDiags diag.Diagnostics
+	}{
+		"equal-identical": {
+			prior:    NewStringValue("1"),
+			proposed: NewStringValue("1"),
+			expected: true,
+		},
+		"equal-trailing-zeros": {
+			prior:    NewStringValue("1.0"),
+			proposed: NewStringValue("1"),
+			expected: true,
+		},
+		"equal-exponent": {
+			prior:    NewStringValue("1e3"),
+			proposed: NewStringValue("1000.00"),
+			expected: true,
+		},
+		"not-equal": {
+			prior:    NewStringValue("1.1"),
+			proposed: NewStringValue("1"),
+			expected: false,
+		},
+		"null-null": {
+			prior:    NewStringNull(),
+			proposed: NewStringNull(),
+			expected: true,
+		},
+		"null-known": {
+			prior:    NewStringNull(),
+			proposed: NewStringValue("1"),
+			expected: false,
+		},
+		"unknown-known": {
+			prior:    NewStringUnknown(),
+			proposed: NewStringValue("1"),
+			expected: false,
+		},
+		"prior-unparseable": {
+			prior:    NewStringValue("abc"),
+			proposed: NewStringValue("1"),
+			expected: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Number String",
+					`Cannot compare the prior value "abc" as a number: number has no digits`,
+				),
+			},
+		},
+		"proposed-unparseable": {
+			prior:    NewStringValue("1"),
+			proposed: NewStringValue("abc"),
+			expected: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Number String",
+					`Cannot compare the proposed value "abc" as a number: number has no digits`,
+				),
+			},
+		},
+		"infinite": {
+			prior:    NewStringValue("Inf"),
+			proposed: NewStringValue("+inf"),
+			expected: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Number String",
+					`Cannot compare the prior value "Inf" as a number: infinite values are not supported`,
+				),
+				diag.NewErrorDiagnostic(
+					"Invalid Number String",
+					`Cannot compare the proposed value "+inf" as a number: infinite values are not supported`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := StringSemanticEqualsNumeric(testCase.prior, testCase.proposed)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringSemanticEqualsFold(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		prior    StringValue
+		proposed StringValue
+		expected bool
+	}{
+		"equal-identical": {
+			prior:    NewStringValue("example.com"),
+			proposed: NewStringValue("example.com"),
+			expected: true,
+		},
+		"equal-case-differing": {
+			prior:    NewStringValue("Example.COM"),
+			proposed: NewStringValue("example.com"),
+			expected: true,
+		},
+		"not-equal-case-differing": {
+			prior:    NewStringValue("Example.COM"),
+			proposed: NewStringValue("example.org"),
+			expected: false,
+		},
+		"equal-multibyte": {
+			prior:    NewStringValue("ÀÉÎÕÜ"),
+			proposed: NewStringValue("àéîõü"),
+			expected: true,
+		},
+		"not-equal-multibyte": {
+			prior:    NewStringValue("ÀÉÎÕÜ"),
+			proposed: NewStringValue("aeiou"),
+			expected: false,
+		},
+		"null-null": {
+			prior:    NewStringNull(),
+			proposed: NewStringNull(),
+			expected: true,
+		},
+		"null-known": {
+			prior:    NewStringNull(),
+			proposed: NewStringValue(""),
+			expected: false,
+		},
+		"unknown-known": {
+			prior:    NewStringUnknown(),
+			proposed: NewStringValue("example.com"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := StringSemanticEqualsFold(testCase.prior, testCase.proposed)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diags.HasError() {
+				t.Errorf("unexpected diagnostics: %s", diags)
+			}
+		})
+	}
+}
+
+func TestStringSemanticEqualsIgnoreWhitespace(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		prior    StringValue
+		proposed StringValue
+		expected bool
+	}{
+		"equal-identical": {
+			prior:    NewStringValue("a b"),
+			proposed: NewStringValue("a b"),
+			expected: true,
+		},
+		"equal-internal-whitespace": {
+			prior:    NewStringValue("a  b\n\tc"),
+			proposed: NewStringValue("a b c"),
+			expected: true,
+		},
+		"equal-leading-whitespace": {
+			prior:    NewStringValue("  a b"),
+			proposed: NewStringValue("a b"),
+			expected: true,
+		},
+		"equal-trailing-newline": {
+			prior:    NewStringValue("a b\n"),
+			proposed: NewStringValue("a b"),
+			expected: true,
+		},
+		"equal-whitespace-only": {
+			prior:    NewStringValue(" \n "),
+			proposed: NewStringValue(""),
+			expected: true,
+		},
+		"not-equal": {
+			prior:    NewStringValue("a b"),
+			proposed: NewStringValue("ab"),
+			expected: false,
+		},
+		"null-null": {
+			prior:    NewStringNull(),
+			proposed: NewStringNull(),
+			expected: true,
+		},
+		"null-known": {
+			prior:    NewStringNull(),
+			proposed: NewStringValue(""),
+			expected: false,
+		},
+		"unknown-known": {
+			prior:    NewStringUnknown(),
+			proposed: NewStringValue("a b"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := StringSemanticEqualsIgnoreWhitespace(testCase.prior, testCase.proposed)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diags.HasError() {
+				t.Errorf("unexpected diagnostics: %s", diags)
+			}
+		})
+	}
+}
+
+func TestStringSemanticEqualsURL(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		prior         StringValue
+		proposed      StringValue
+		expected      bool
+		expectedDiags diag.Diagnostics
+	}{
+		"equal-identical": {
+			prior:    NewStringValue("https://example.com/a"),
+			proposed: NewStringValue("https://example.com/a"),
+			expected: true,
+		},
+		"equal-scheme-host-case": {
+			prior:    NewStringValue("HTTPS://Example.COM/a"),
+			proposed: NewStringValue("https://example.com/a"),
+			expected: true,
+		},
+		"equal-default-port-http": {
+			prior:    NewStringValue("http://example.com:80/a"),
+			proposed: NewStringValue("http://example.com/a"),
+			expected: true,
+		},
+		"equal-default-port-https": {
+			prior:    NewStringValue("https://example.com:443/a"),
+			proposed: NewStringValue("https://example.com/a"),
+			expected: true,
+		},
+		"equal-default-port-ipv6": {
+			prior:    NewStringValue("https://[::1]:443/a"),
+			proposed: NewStringValue("https://[::1]/a"),
+			expected: true,
+		},
+		"equal-empty-path": {
+			prior:    NewStringValue("https://example.com"),
+			proposed: NewStringValue("https://example.com/"),
+			expected: true,
+		},
+		"equal-trailing-slash": {
+			prior:    NewStringValue("https://example.com/a/"),
+			proposed: NewStringValue("https://example.com/a"),
+			expected: true,
+		},
+		"equal-dot-segments": {
+			prior:    NewStringValue("https://example.com/a/./b/../c"),
+			proposed: NewStringValue("https://example.com/a/c"),
+			expected: true,
+		},
+		"not-equal-host": {
+			prior:    NewStringValue("https://example.com/a"),
+			proposed: NewStringValue("https://example.org/a"),
+			expected: false,
+		},
+		"not-equal-scheme": {
+			prior:    NewStringValue("http://example.com/a"),
+			proposed: NewStringValue("https://example.com/a"),
+			expected: false,
+		},
+		"not-equal-non-default-port": {
+			prior:    NewStringValue("http://example.com:443/a"),
+			proposed: NewStringValue("http://example.com/a"),
+			expected: false,
+		},
+		"not-equal-path-case": {
+			prior:    NewStringValue("https://example.com/A"),
+			proposed: NewStringValue("https://example.com/a"),
+			expected: false,
+		},
+		"not-equal-encoded-slash": {
+			prior:    NewStringValue("https://example.com/x%2Fy"),
+			proposed: NewStringValue("https://example.com/x/y"),
+			expected: false,
+		},
+		"equal-encoded-slash": {
+			prior:    NewStringValue("HTTPS://example.com/x%2Fy/"),
+			proposed: NewStringValue("https://example.com/x%2Fy"),
+			expected: true,
+		},
+		"not-equal-relative-reference": {
+			prior:    NewStringValue("./a"),
+			proposed: NewStringValue("a"),
+			expected: false,
+		},
+		"equal-relative-reference": {
+			prior:    NewStringValue("a/b"),
+			proposed: NewStringValue("a/b"),
+			expected: true,
+		},
+		"not-equal-query": {
+			prior:    NewStringValue("https://example.com/a?b=1"),
+			proposed: NewStringValue("https://example.com/a?b=2"),
+			expected: false,
+		},
+		"null-null": {
+			prior:    NewStringNull(),
+			proposed: NewStringNull(),
+			expected: true,
+		},
+		"null-known": {
+			prior:    NewStringNull(),
+			proposed: NewStringValue("https://example.com"),
+			expected: false,
+		},
+		"unknown-known": {
+			prior:    NewStringUnknown(),
+			proposed: NewStringValue("https://example.com"),
+			expected: false,
+		},
+		"prior-unparseable": {
+			prior:    NewStringValue("://example.com"),
+			proposed: NewStringValue("https://example.com"),
+			expected: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid URL String",
+					`Cannot compare the prior value "://example.com" as a URL: parse "://example.com": missing protocol scheme`,
+				),
+			},
+		},
+		"proposed-unparseable": {
+			prior:    NewStringValue("https://example.com"),
+			proposed: NewStringValue("://example.com"),
+			expected: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid URL String",
+					`Cannot compare the proposed value "://example.com" as a URL: parse "://example.com": missing protocol scheme`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := StringSemanticEqualsURL(testCase.prior, testCase.proposed)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}